@@ -1,67 +1,71 @@
 package catalog
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
 	"net/http"
 	"net/url"
-)
 
-type clientConfig struct {
-	token   string
-	baseURL string
-}
+	"go.einride.tech/backstage/internal/backstagehttp"
+)
 
 // ClientOption configures a [Client].
-type ClientOption func(*clientConfig)
+//
+// The options also configure the clients of the other Backstage APIs, such as the scaffolder and search clients.
+type ClientOption = backstagehttp.Option
 
 // WithToken sets the bearer token to use for authentication.
+//
+// An empty token disables authentication.
 func WithToken(token string) ClientOption {
-	return func(config *clientConfig) {
-		config.token = token
+	if token == "" {
+		return WithTokenSource(nil)
+	}
+	return WithTokenSource(StaticTokenSource(token))
+}
+
+// WithTokenSource sets the source of bearer tokens to use for authentication.
+//
+// The token source is called for every request.
+func WithTokenSource(tokenSource TokenSource) ClientOption {
+	return func(config *backstagehttp.Config) {
+		config.TokenSource = tokenSource
 	}
 }
 
 // WithBaseURL sets the backend base URL.
 func WithBaseURL(baseURL string) ClientOption {
-	return func(config *clientConfig) {
-		config.baseURL = baseURL
+	return func(config *backstagehttp.Config) {
+		config.BaseURL = baseURL
 	}
 }
 
-// Client to the Backstage Catalog API.
-type Client struct {
-	config     clientConfig
-	httpClient *http.Client
+// WithHTTPClient sets the HTTP client to base the client's HTTP client on.
+//
+// The provided client is copied and never modified. Authentication is added on top of the client's transport.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(config *backstagehttp.Config) {
+		config.HTTPClient = httpClient
+	}
 }
 
-// NewClient creates a new catalog API [Client].
-func NewClient(options ...ClientOption) *Client {
-	client := &Client{
-		httpClient: http.DefaultClient,
-	}
-	for _, option := range options {
-		option(&client.config)
+// WithTransport sets the HTTP transport to use for requests.
+//
+// Authentication is added on top of the provided transport. Takes precedence over the transport of a client
+// provided with [WithHTTPClient].
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(config *backstagehttp.Config) {
+		config.Transport = transport
 	}
-	if client.config.token != "" {
-		client.httpClient.Transport = &tokenRoundTripper{
-			token: client.config.token,
-			next:  http.DefaultTransport,
-		}
-	}
-	return client
 }
 
-type tokenRoundTripper struct {
-	token string
-	next  http.RoundTripper
+// Client to the Backstage Catalog API.
+type Client struct {
+	client *backstagehttp.Client
 }
 
-func (t *tokenRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
-	request.Header.Set("Authorization", "Bearer "+t.token)
-	return t.next.RoundTrip(request)
+// NewClient creates a new catalog API [Client].
+func NewClient(options ...ClientOption) *Client {
+	return &Client{client: backstagehttp.NewClient(options...)}
 }
 
 func (c *Client) get(
@@ -69,99 +73,23 @@ func (c *Client) get(
 	path string,
 	query url.Values,
 	fn func(*http.Response) error,
-) (err error) {
-	const method = http.MethodGet
-	defer func() {
-		if err != nil {
-			err = fmt.Errorf("%s %s: %w", method, path, err)
-		}
-	}()
-	requestURL, err := url.Parse(c.config.baseURL + path)
-	if err != nil {
-		return err
-	}
-	if len(query) > 0 {
-		requestURL.RawQuery = query.Encode()
-	}
-	httpRequest, err := http.NewRequestWithContext(ctx, method, requestURL.String(), nil)
-	if err != nil {
-		return err
-	}
-	httpResponse, err := c.httpClient.Do(httpRequest)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		_ = httpResponse.Body.Close()
-	}()
-	if httpResponse.StatusCode != http.StatusOK {
-		return newStatusError(httpResponse)
-	}
-	if fn != nil {
-		return fn(httpResponse)
-	}
-	return nil
+) error {
+	return c.client.Get(ctx, path, query, fn)
 }
 
 func (c *Client) post(
 	ctx context.Context,
 	path string,
+	query url.Values,
 	body any,
 	fn func(*http.Response) error,
-) (err error) {
-	const method = http.MethodPost
-	defer func() {
-		if err != nil {
-			err = fmt.Errorf("%s %s: %w", method, path, err)
-		}
-	}()
-	bodyData, err := json.Marshal(body)
-	if err != nil {
-		return err
-	}
-	httpRequest, err := http.NewRequestWithContext(ctx, method, c.config.baseURL+path, bytes.NewReader(bodyData))
-	if err != nil {
-		return err
-	}
-	httpRequest.Header.Set("Content-Type", "application/json")
-	httpResponse, err := c.httpClient.Do(httpRequest)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		_ = httpResponse.Body.Close()
-	}()
-	if httpResponse.StatusCode != http.StatusOK {
-		return newStatusError(httpResponse)
-	}
-	if fn != nil {
-		return fn(httpResponse)
-	}
-	return nil
+) error {
+	return c.client.Post(ctx, path, query, body, fn)
 }
 
 func (c *Client) delete(
 	ctx context.Context,
 	path string,
-) (err error) {
-	defer func() {
-		if err != nil {
-			err = fmt.Errorf("%s %s: %w", http.MethodDelete, path, err)
-		}
-	}()
-	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.config.baseURL+path, nil)
-	if err != nil {
-		return err
-	}
-	httpResponse, err := c.httpClient.Do(httpRequest)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		_ = httpResponse.Body.Close()
-	}()
-	if httpResponse.StatusCode != http.StatusNoContent {
-		return newStatusError(httpResponse)
-	}
-	return nil
+) error {
+	return c.client.Delete(ctx, path)
 }
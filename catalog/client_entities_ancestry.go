@@ -0,0 +1,60 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetEntityAncestryByNameRequest is the request to the [Client.GetEntityAncestryByName] method.
+type GetEntityAncestryByNameRequest struct {
+	// Kind of the entity to get ancestry for.
+	Kind string
+	// Namespace of the entity to get ancestry for.
+	Namespace string
+	// Name of the entity to get ancestry for.
+	Name string
+}
+
+// GetEntityAncestryByNameResponse is the response from the [Client.GetEntityAncestryByName] method.
+type GetEntityAncestryByNameResponse struct {
+	// RootEntityRef is the entity ref of the entity that the ancestry was requested for.
+	RootEntityRef string `json:"rootEntityRef"`
+
+	// Items in the ancestry, including the root entity.
+	Items []*EntityAncestryItem `json:"items"`
+}
+
+// EntityAncestryItem is an entity in an ancestry, together with the entities that emitted it.
+type EntityAncestryItem struct {
+	// Entity in the ancestry.
+	Entity *Entity `json:"entity"`
+
+	// ParentEntityRefs are the entity refs of the entities that emitted the entity.
+	ParentEntityRefs []string `json:"parentEntityRefs"`
+}
+
+// GetEntityAncestryByName gets an entity's ancestry, i.e. the chain of entities (typically Locations) that emitted it.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#get-entitiesby-namekindnamespacename
+func (c *Client) GetEntityAncestryByName(
+	ctx context.Context,
+	request *GetEntityAncestryByNameRequest,
+) (*GetEntityAncestryByNameResponse, error) {
+	const pathTemplate = "/api/catalog/entities/by-name/%s/%s/%s/ancestry"
+	path := fmt.Sprintf(
+		pathTemplate,
+		url.PathEscape(request.Kind),
+		url.PathEscape(request.Namespace),
+		url.PathEscape(request.Name),
+	)
+	var response GetEntityAncestryByNameResponse
+	if err := c.get(ctx, path, nil, func(r *http.Response) error {
+		return json.NewDecoder(r.Body).Decode(&response)
+	}); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,82 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_GetEntityAncestryByName(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		const (
+			component = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"baz"}}`
+			location  = `{"apiVersion":"backstage.io/v1alpha1","kind":"Location","metadata":{"name":"root"}}`
+		)
+		expected := &GetEntityAncestryByNameResponse{
+			RootEntityRef: "component:bar/baz",
+			Items: []*EntityAncestryItem{
+				{
+					Entity: &Entity{
+						APIVersion: "backstage.io/v1alpha1",
+						Kind:       EntityKindComponent,
+						Metadata:   EntityMetadata{Name: "baz"},
+						Raw:        json.RawMessage(component),
+					},
+					ParentEntityRefs: []string{"location:default/root"},
+				},
+				{
+					Entity: &Entity{
+						APIVersion: "backstage.io/v1alpha1",
+						Kind:       EntityKindLocation,
+						Metadata:   EntityMetadata{Name: "root"},
+						Raw:        json.RawMessage(location),
+					},
+					ParentEntityRefs: []string{},
+				},
+			},
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/entities/by-name/component/bar/baz/ancestry", r.URL.Path)
+			_, _ = w.Write([]byte(fmt.Sprintf(
+				`{"rootEntityRef":"component:bar/baz","items":[`+
+					`{"entity":%s,"parentEntityRefs":["location:default/root"]},`+
+					`{"entity":%s,"parentEntityRefs":[]}]}`,
+				component,
+				location,
+			)))
+		})
+		actual, err := client.GetEntityAncestryByName(ctx, &GetEntityAncestryByNameRequest{
+			Kind:      "component",
+			Namespace: "bar",
+			Name:      "baz",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusNotFound
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/entities/by-name/component/bar/baz/ancestry", r.URL.Path)
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.GetEntityAncestryByName(ctx, &GetEntityAncestryByNameRequest{
+			Kind:      "component",
+			Namespace: "bar",
+			Name:      "baz",
+		})
+		assert.Assert(t, response == nil)
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
@@ -33,7 +33,7 @@ func (c *Client) BatchGetEntitiesByRefs(
 	var responseBody struct {
 		Items []*Entity
 	}
-	if err := c.post(ctx, path, request, func(r *http.Response) error {
+	if err := c.post(ctx, path, nil, request, func(r *http.Response) error {
 		return json.NewDecoder(r.Body).Decode(&responseBody)
 	}); err != nil {
 		return nil, err
@@ -0,0 +1,56 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// GetEntityFacetsRequest is the request to the [Client.GetEntityFacets] method.
+type GetEntityFacetsRequest struct {
+	// Facets to count values of, e.g. "kind", "spec.owner" or "metadata.tags".
+	Facets []string
+	// Filters for selecting only a subset of all entities.
+	Filters []string
+}
+
+// GetEntityFacetsResponse is the response from the [Client.GetEntityFacets] method.
+type GetEntityFacetsResponse struct {
+	// Facets maps each requested facet to the distinct values found and their counts.
+	Facets map[string][]EntityFacet
+}
+
+// EntityFacet is a distinct value of a facet and the number of entities having that value.
+type EntityFacet struct {
+	// Value of the facet.
+	Value string `json:"value"`
+	// Count of entities with the value.
+	Count int64 `json:"count"`
+}
+
+// GetEntityFacets gets the distinct values and counts of one or more entity fields.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#get-entity-facets
+func (c *Client) GetEntityFacets(
+	ctx context.Context,
+	request *GetEntityFacetsRequest,
+) (*GetEntityFacetsResponse, error) {
+	const path = "/api/catalog/entity-facets"
+	query := make(url.Values)
+	for _, facet := range request.Facets {
+		query.Add("facet", facet)
+	}
+	for _, filter := range request.Filters {
+		query.Add("filter", filter)
+	}
+	var responseBody struct {
+		Facets map[string][]EntityFacet `json:"facets"`
+	}
+	if err := c.get(ctx, path, query, func(response *http.Response) error {
+		return json.NewDecoder(response.Body).Decode(&responseBody)
+	}); err != nil {
+		return nil, err
+	}
+	return &GetEntityFacetsResponse{Facets: responseBody.Facets}, nil
+}
@@ -0,0 +1,57 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_GetEntityFacets(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		expected := &GetEntityFacetsResponse{
+			Facets: map[string][]EntityFacet{
+				"spec.lifecycle": {
+					{Value: "production", Count: 12},
+					{Value: "experimental", Count: 3},
+				},
+				"metadata.tags": {
+					{Value: "go", Count: 7},
+				},
+			},
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/entity-facets", r.URL.Path)
+			assert.DeepEqual(t, []string{"spec.lifecycle", "metadata.tags"}, r.URL.Query()["facet"])
+			assert.DeepEqual(t, []string{"kind=Component"}, r.URL.Query()["filter"])
+			_, _ = w.Write([]byte(`{"facets":{` +
+				`"spec.lifecycle":[{"value":"production","count":12},{"value":"experimental","count":3}],` +
+				`"metadata.tags":[{"value":"go","count":7}]}}`))
+		})
+		actual, err := client.GetEntityFacets(ctx, &GetEntityFacetsRequest{
+			Facets:  []string{"spec.lifecycle", "metadata.tags"},
+			Filters: []string{"kind=Component"},
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusBadRequest
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/entity-facets", r.URL.Path)
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.GetEntityFacets(ctx, &GetEntityFacetsRequest{})
+		assert.Assert(t, response == nil)
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
@@ -39,25 +39,9 @@ var linkURLRegexp = regexp.MustCompile(`<(.*)>; *rel="next"`)
 // See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#get-entities
 func (c *Client) ListEntities(ctx context.Context, request *ListEntitiesRequest) (*ListEntitiesResponse, error) {
 	const path = "/api/catalog/entities"
-	query := make(url.Values)
-	if request.Offset > 0 {
-		query.Set("offset", strconv.FormatInt(request.Offset, 10))
-	}
-	if request.Limit > 0 {
-		query.Set("limit", strconv.FormatInt(request.Limit, 10))
-	}
-	for _, filter := range request.Filters {
-		query.Add("filter", filter)
-	}
-	if len(request.Fields) > 0 {
-		query.Set("fields", strings.Join(request.Fields, ","))
-	}
-	if request.After != "" {
-		query.Set("after", request.After)
-	}
 	var entities []*Entity
 	var nextPageToken string
-	if err := c.get(ctx, path, query, func(response *http.Response) error {
+	if err := c.get(ctx, path, request.query(), func(response *http.Response) error {
 		for _, link := range response.Header.Values("link") {
 			if matches := linkURLRegexp.FindStringSubmatch(link); len(matches) > 1 {
 				linkURL, err := url.ParseRequestURI(matches[1])
@@ -76,3 +60,23 @@ func (c *Client) ListEntities(ctx context.Context, request *ListEntitiesRequest)
 		NextPageToken: nextPageToken,
 	}, nil
 }
+
+func (r *ListEntitiesRequest) query() url.Values {
+	query := make(url.Values)
+	if r.Offset > 0 {
+		query.Set("offset", strconv.FormatInt(r.Offset, 10))
+	}
+	if r.Limit > 0 {
+		query.Set("limit", strconv.FormatInt(r.Limit, 10))
+	}
+	for _, filter := range r.Filters {
+		query.Add("filter", filter)
+	}
+	if len(r.Fields) > 0 {
+		query.Set("fields", strings.Join(r.Fields, ","))
+	}
+	if r.After != "" {
+		query.Set("after", r.After)
+	}
+	return query
+}
@@ -0,0 +1,103 @@
+package catalog
+
+import (
+	"context"
+	"iter"
+)
+
+// ListEntitiesAllRequest is the request to the [Client.ListEntitiesAll] method.
+type ListEntitiesAllRequest struct {
+	// Filters for selecting only a subset of all entities.
+	Filters []string
+	// Fields for selecting only parts of the full data structure of each entity.
+	Fields []string
+	// PageSize is the number of entities to fetch per page. Defaults to 100.
+	PageSize int64
+	// Prefetch enables fetching the next page concurrently while the current page is being iterated.
+	Prefetch bool
+}
+
+const defaultListEntitiesAllPageSize = 100
+
+// ListEntitiesAll returns an iterator over all entities in the catalog matching the request.
+//
+// Pages are fetched lazily using [Client.ListEntities], and iteration can be stopped early. If fetching a page fails,
+// the error is yielded and the iteration stops.
+func (c *Client) ListEntitiesAll(ctx context.Context, request *ListEntitiesAllRequest) iter.Seq2[*Entity, error] {
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultListEntitiesAllPageSize
+	}
+	fetchPage := func(ctx context.Context, after string) (*ListEntitiesResponse, error) {
+		return c.ListEntities(ctx, &ListEntitiesRequest{
+			Filters: request.Filters,
+			Fields:  request.Fields,
+			Limit:   pageSize,
+			After:   after,
+		})
+	}
+	if request.Prefetch {
+		return func(yield func(*Entity, error) bool) {
+			listEntitiesPrefetch(ctx, fetchPage, yield)
+		}
+	}
+	return func(yield func(*Entity, error) bool) {
+		var after string
+		for {
+			response, err := fetchPage(ctx, after)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, entity := range response.Entities {
+				if !yield(entity, nil) {
+					return
+				}
+			}
+			if response.NextPageToken == "" {
+				return
+			}
+			after = response.NextPageToken
+		}
+	}
+}
+
+func listEntitiesPrefetch(
+	ctx context.Context,
+	fetchPage func(context.Context, string) (*ListEntitiesResponse, error),
+	yield func(*Entity, error) bool,
+) {
+	// Cancel any in-flight prefetch when the iteration stops.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type page struct {
+		response *ListEntitiesResponse
+		err      error
+	}
+	startFetchPage := func(after string) <-chan page {
+		// Buffered, so that the fetch can complete even if the iteration stops.
+		result := make(chan page, 1)
+		go func() {
+			response, err := fetchPage(ctx, after)
+			result <- page{response: response, err: err}
+		}()
+		return result
+	}
+	next := startFetchPage("")
+	for next != nil {
+		current := <-next
+		if current.err != nil {
+			yield(nil, current.err)
+			return
+		}
+		next = nil
+		if current.response.NextPageToken != "" {
+			next = startFetchPage(current.response.NextPageToken)
+		}
+		for _, entity := range current.response.Entities {
+			if !yield(entity, nil) {
+				return
+			}
+		}
+	}
+}
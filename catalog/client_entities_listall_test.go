@@ -0,0 +1,99 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_ListEntitiesAll(t *testing.T) {
+	ctx := context.Background()
+	// Serve 3 pages of 2 entities each.
+	newPagedTestClient := func(t *testing.T, requests *atomic.Int64) *Client {
+		return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/entities", r.URL.Path)
+			assert.Equal(t, "kind=System", r.URL.Query().Get("filter"))
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			var page int
+			switch after := r.URL.Query().Get("after"); after {
+			case "":
+				page = 0
+			case "page1":
+				page = 1
+			case "page2":
+				page = 2
+			default:
+				t.Errorf("unexpected cursor: %s", after)
+			}
+			if page < 2 {
+				w.Header().Set("link", fmt.Sprintf(`</api/catalog/entities?after=page%d>; rel="next"`, page+1))
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(
+				`[{"kind":"System","metadata":{"name":"system%d"}},{"kind":"System","metadata":{"name":"system%d"}}]`,
+				2*page,
+				2*page+1,
+			)))
+		})
+	}
+
+	for _, prefetch := range []bool{false, true} {
+		t.Run(fmt.Sprintf("prefetch=%v", prefetch), func(t *testing.T) {
+			t.Run("all pages", func(t *testing.T) {
+				var requests atomic.Int64
+				client := newPagedTestClient(t, &requests)
+				var names []string
+				for entity, err := range client.ListEntitiesAll(ctx, &ListEntitiesAllRequest{
+					Filters:  []string{"kind=System"},
+					PageSize: 2,
+					Prefetch: prefetch,
+				}) {
+					assert.NilError(t, err)
+					names = append(names, entity.Metadata.Name)
+				}
+				assert.DeepEqual(t, []string{"system0", "system1", "system2", "system3", "system4", "system5"}, names)
+				assert.Equal(t, int64(3), requests.Load())
+			})
+
+			t.Run("stop early", func(t *testing.T) {
+				var requests atomic.Int64
+				client := newPagedTestClient(t, &requests)
+				var names []string
+				for entity, err := range client.ListEntitiesAll(ctx, &ListEntitiesAllRequest{
+					Filters:  []string{"kind=System"},
+					PageSize: 2,
+					Prefetch: prefetch,
+				}) {
+					assert.NilError(t, err)
+					names = append(names, entity.Metadata.Name)
+					if len(names) == 3 {
+						break
+					}
+				}
+				assert.DeepEqual(t, []string{"system0", "system1", "system2"}, names)
+				assert.Assert(t, requests.Load() <= 3)
+			})
+
+			t.Run("fail", func(t *testing.T) {
+				client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				})
+				var count int
+				for entity, err := range client.ListEntitiesAll(ctx, &ListEntitiesAllRequest{Prefetch: prefetch}) {
+					count++
+					assert.Assert(t, entity == nil)
+					var errStatus *StatusError
+					assert.Assert(t, errors.As(err, &errStatus))
+					assert.Equal(t, http.StatusInternalServerError, errStatus.StatusCode)
+				}
+				assert.Equal(t, 1, count)
+			})
+		})
+	}
+}
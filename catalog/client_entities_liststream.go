@@ -0,0 +1,61 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// ListEntitiesStream lists entities in the catalog, decoding the response one entity at a time.
+//
+// Unlike [Client.ListEntities], the response is never held in memory in full, which makes ListEntitiesStream suitable
+// for large unpaginated lists. Iteration can be stopped early, which closes the response. If the request or decoding
+// fails, the error is yielded and the iteration stops.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#get-entities
+func (c *Client) ListEntitiesStream(ctx context.Context, request *ListEntitiesRequest) iter.Seq2[*Entity, error] {
+	return func(yield func(*Entity, error) bool) {
+		const path = "/api/catalog/entities"
+		stopped := false
+		if err := c.get(ctx, path, request.query(), func(response *http.Response) error {
+			decoder := json.NewDecoder(response.Body)
+			if err := expectDelim(decoder, '['); err != nil {
+				return err
+			}
+			for decoder.More() {
+				// Decode via a raw message, since the decoder's buffer is reused and Entity retains its raw JSON.
+				var raw json.RawMessage
+				if err := decoder.Decode(&raw); err != nil {
+					return err
+				}
+				var entity Entity
+				if err := json.Unmarshal(raw, &entity); err != nil {
+					return err
+				}
+				if !yield(&entity, nil) {
+					stopped = true
+					return errStopIteration
+				}
+			}
+			return expectDelim(decoder, ']')
+		}); err != nil && !stopped {
+			yield(nil, err)
+		}
+	}
+}
+
+var errStopIteration = errors.New("stop iteration")
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v but got %v", delim, token)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_ListEntitiesStream(t *testing.T) {
+	ctx := context.Background()
+	const (
+		system1 = `{"apiVersion":"backstage.io/v1alpha1","kind":"System","metadata":{"name":"system1"}}`
+		system2 = `{"apiVersion":"backstage.io/v1alpha1","kind":"System","metadata":{"name":"system2"}}`
+	)
+
+	t.Run("success", func(t *testing.T) {
+		expected := []*Entity{
+			{
+				APIVersion: "backstage.io/v1alpha1",
+				Kind:       EntityKindSystem,
+				Metadata: EntityMetadata{
+					Name: "system1",
+				},
+				Raw: json.RawMessage(system1),
+			},
+			{
+				APIVersion: "backstage.io/v1alpha1",
+				Kind:       EntityKindSystem,
+				Metadata: EntityMetadata{
+					Name: "system2",
+				},
+				Raw: json.RawMessage(system2),
+			},
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/entities", r.URL.Path)
+			assert.Equal(t, "kind=System", r.URL.Query().Get("filter"))
+			_, _ = w.Write([]byte(fmt.Sprintf(`[%s, %s]`, system1, system2)))
+		})
+		var actual []*Entity
+		for entity, err := range client.ListEntitiesStream(ctx, &ListEntitiesRequest{
+			Filters: []string{"kind=System"},
+		}) {
+			assert.NilError(t, err)
+			actual = append(actual, entity)
+		}
+		assert.DeepEqual(t, expected, actual)
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(fmt.Sprintf(`[%s,%s]`, system1, system2)))
+		})
+		var count int
+		for entity, err := range client.ListEntitiesStream(ctx, &ListEntitiesRequest{}) {
+			assert.NilError(t, err)
+			assert.Equal(t, "system1", entity.Metadata.Name)
+			count++
+			break
+		}
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"items":[]}`))
+		})
+		var errs []error
+		for _, err := range client.ListEntitiesStream(ctx, &ListEntitiesRequest{}) {
+			errs = append(errs, err)
+		}
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorContains(t, errs[0], "expected [")
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusInternalServerError
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(statusCode)
+		})
+		var errs []error
+		for entity, err := range client.ListEntitiesStream(ctx, &ListEntitiesRequest{}) {
+			assert.Assert(t, entity == nil)
+			errs = append(errs, err)
+		}
+		assert.Equal(t, 1, len(errs))
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(errs[0], &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
+
+// Compare peak heap usage of listing a large catalog with and without streaming.
+//
+// Run with: go test -run=^$ -bench=ListEntities ./catalog
+func BenchmarkClient_ListEntities(b *testing.B) {
+	ctx := context.Background()
+	const numEntities = 10_000
+	var body bytes.Buffer
+	body.WriteByte('[')
+	for i := range numEntities {
+		if i > 0 {
+			body.WriteByte(',')
+		}
+		_, _ = fmt.Fprintf(
+			&body,
+			`{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"component%d",`+
+				`"description":"A component with a reasonably long description, as found in real catalogs.",`+
+				`"annotations":{"github.com/project-slug":"example/component%d"},"tags":["go","backend"]},`+
+				`"spec":{"type":"service","lifecycle":"production","owner":"team-%d"}}`,
+			i,
+			i,
+			i%10,
+		)
+	}
+	body.WriteByte(']')
+	client := newTestClient(b, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(body.Bytes())
+	})
+
+	b.Run("ListEntities", func(b *testing.B) {
+		b.ReportAllocs()
+		var peak peakHeap
+		for range b.N {
+			peak.reset()
+			response, err := client.ListEntities(ctx, &ListEntitiesRequest{})
+			if err != nil {
+				b.Fatal(err)
+			}
+			peak.sample()
+			if len(response.Entities) != numEntities {
+				b.Fatalf("got %d entities", len(response.Entities))
+			}
+		}
+		peak.report(b)
+	})
+
+	b.Run("ListEntitiesStream", func(b *testing.B) {
+		b.ReportAllocs()
+		var peak peakHeap
+		for range b.N {
+			peak.reset()
+			var count int
+			for _, err := range client.ListEntitiesStream(ctx, &ListEntitiesRequest{}) {
+				if err != nil {
+					b.Fatal(err)
+				}
+				if count++; count%1000 == 0 {
+					peak.sample()
+				}
+			}
+			peak.sample()
+			if count != numEntities {
+				b.Fatalf("got %d entities", count)
+			}
+		}
+		peak.report(b)
+	})
+}
+
+// peakHeap tracks the peak heap usage in bytes above a baseline.
+type peakHeap struct {
+	baseline uint64
+	peak     uint64
+}
+
+func (p *peakHeap) reset() {
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	p.baseline = stats.HeapAlloc
+}
+
+func (p *peakHeap) sample() {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	if stats.HeapAlloc > p.baseline {
+		p.peak = max(p.peak, stats.HeapAlloc-p.baseline)
+	}
+}
+
+func (p *peakHeap) report(b *testing.B) {
+	b.ReportMetric(float64(p.peak), "peak-heap-B")
+}
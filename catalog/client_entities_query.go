@@ -0,0 +1,92 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// QueryEntitiesRequest is the request to the [Client.QueryEntities] method.
+type QueryEntitiesRequest struct {
+	// Filters for selecting only a subset of all entities.
+	Filters []string
+	// Fields for selecting only parts of the full data structure of each entity.
+	Fields []string
+	// OrderFields for sorting the returned entities.
+	OrderFields []Ordering
+	// FullTextFilterTerm for selecting only entities matching the provided search term.
+	FullTextFilterTerm string
+	// FullTextFilterFields for selecting which fields to match the full text filter term against.
+	FullTextFilterFields []string
+	// Limit for pagination.
+	Limit int64
+	// Cursor for returning a page from a previous response.
+	//
+	// When a cursor is provided, all other parameters except Limit and Fields are ignored,
+	// since they are encoded in the cursor.
+	Cursor string
+}
+
+// QueryEntitiesResponse is the response from the [Client.QueryEntities] method.
+type QueryEntitiesResponse struct {
+	// Entities in the response.
+	Entities []*Entity
+	// TotalItems is the total number of entities matching the query.
+	TotalItems int64
+	// NextCursor is the cursor for the next page, if any.
+	NextCursor string
+	// PrevCursor is the cursor for the previous page, if any.
+	PrevCursor string
+}
+
+// QueryEntities queries entities in the catalog, with support for ordering, full-text filtering and cursor pagination.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#get-entitiesby-query
+func (c *Client) QueryEntities(ctx context.Context, request *QueryEntitiesRequest) (*QueryEntitiesResponse, error) {
+	const path = "/api/catalog/entities/by-query"
+	query := make(url.Values)
+	if request.Limit > 0 {
+		query.Set("limit", strconv.FormatInt(request.Limit, 10))
+	}
+	if len(request.Fields) > 0 {
+		query.Set("fields", strings.Join(request.Fields, ","))
+	}
+	if request.Cursor != "" {
+		query.Set("cursor", request.Cursor)
+	} else {
+		for _, filter := range request.Filters {
+			query.Add("filter", filter)
+		}
+		for _, orderField := range request.OrderFields {
+			query.Add("orderField", orderField.String())
+		}
+		if request.FullTextFilterTerm != "" {
+			query.Set("fullTextFilterTerm", request.FullTextFilterTerm)
+		}
+		if len(request.FullTextFilterFields) > 0 {
+			query.Set("fullTextFilterFields", strings.Join(request.FullTextFilterFields, ","))
+		}
+	}
+	var responseBody struct {
+		Items      []*Entity `json:"items"`
+		TotalItems int64     `json:"totalItems"`
+		PageInfo   struct {
+			NextCursor string `json:"nextCursor"`
+			PrevCursor string `json:"prevCursor"`
+		} `json:"pageInfo"`
+	}
+	if err := c.get(ctx, path, query, func(response *http.Response) error {
+		return json.NewDecoder(response.Body).Decode(&responseBody)
+	}); err != nil {
+		return nil, err
+	}
+	return &QueryEntitiesResponse{
+		Entities:   responseBody.Items,
+		TotalItems: responseBody.TotalItems,
+		NextCursor: responseBody.PageInfo.NextCursor,
+		PrevCursor: responseBody.PageInfo.PrevCursor,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_QueryEntities(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		const (
+			system1 = `{"apiVersion":"backstage.io/v1alpha1","kind":"System","metadata":{"name":"system1"}}`
+			system2 = `{"apiVersion":"backstage.io/v1alpha1","kind":"System","metadata":{"name":"system2"}}`
+		)
+		expected := &QueryEntitiesResponse{
+			Entities: []*Entity{
+				{
+					APIVersion: "backstage.io/v1alpha1",
+					Kind:       EntityKindSystem,
+					Metadata: EntityMetadata{
+						Name: "system1",
+					},
+					Raw: json.RawMessage(system1),
+				},
+				{
+					APIVersion: "backstage.io/v1alpha1",
+					Kind:       EntityKindSystem,
+					Metadata: EntityMetadata{
+						Name: "system2",
+					},
+					Raw: json.RawMessage(system2),
+				},
+			},
+			TotalItems: 42,
+			NextCursor: "next",
+			PrevCursor: "prev",
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/entities/by-query", r.URL.Path)
+			assert.DeepEqual(t, []string{"kind=System", "spec.type=service"}, r.URL.Query()["filter"])
+			assert.DeepEqual(t, []string{"metadata.name,desc", "kind,asc"}, r.URL.Query()["orderField"])
+			assert.Equal(t, "kind,metadata", r.URL.Query().Get("fields"))
+			assert.Equal(t, "foo", r.URL.Query().Get("fullTextFilterTerm"))
+			assert.Equal(t, "metadata.name,metadata.title", r.URL.Query().Get("fullTextFilterFields"))
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			assert.Equal(t, "", r.URL.Query().Get("cursor"))
+			_, _ = w.Write([]byte(fmt.Sprintf(
+				`{"items":[%s,%s],"totalItems":42,"pageInfo":{"nextCursor":"next","prevCursor":"prev"}}`,
+				system1,
+				system2,
+			)))
+		})
+		actual, err := client.QueryEntities(ctx, &QueryEntitiesRequest{
+			Filters: []string{"kind=System", "spec.type=service"},
+			Fields:  []string{"kind", "metadata"},
+			OrderFields: []Ordering{
+				{Field: "metadata.name", Desc: true},
+				{Field: "kind"},
+			},
+			FullTextFilterTerm:   "foo",
+			FullTextFilterFields: []string{"metadata.name", "metadata.title"},
+			Limit:                2,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/catalog/entities/by-query", r.URL.Path)
+			assert.Equal(t, "next", r.URL.Query().Get("cursor"))
+			assert.Equal(t, "10", r.URL.Query().Get("limit"))
+			assert.Assert(t, !r.URL.Query().Has("filter"))
+			assert.Assert(t, !r.URL.Query().Has("orderField"))
+			_, _ = w.Write([]byte(`{"items":[],"totalItems":0,"pageInfo":{}}`))
+		})
+		actual, err := client.QueryEntities(ctx, &QueryEntitiesRequest{
+			Filters:     []string{"kind=System"},
+			OrderFields: []Ordering{{Field: "metadata.name"}},
+			Limit:       10,
+			Cursor:      "next",
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, "", actual.NextCursor)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusBadRequest
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/entities/by-query", r.URL.Path)
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.QueryEntities(ctx, &QueryEntitiesRequest{})
+		assert.Assert(t, response == nil)
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
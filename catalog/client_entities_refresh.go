@@ -0,0 +1,78 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RefreshEntityRequest is the request to the [Client.RefreshEntity] method.
+type RefreshEntityRequest struct {
+	// EntityRef of the entity to refresh.
+	// See: https://backstage.io/docs/features/software-catalog/references
+	EntityRef string `json:"entityRef"`
+}
+
+// RefreshEntity schedules an entity to be refreshed as soon as possible.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#post-refresh
+func (c *Client) RefreshEntity(ctx context.Context, request *RefreshEntityRequest) error {
+	const path = "/api/catalog/refresh"
+	return c.post(ctx, path, nil, request, nil)
+}
+
+// RefreshEntityAndWaitRequest is the request to the [Client.RefreshEntityAndWait] method.
+type RefreshEntityAndWaitRequest struct {
+	// EntityRef of the entity to refresh, on the format <kind>:[<namespace>/]<name>.
+	// See: https://backstage.io/docs/features/software-catalog/references
+	EntityRef string
+
+	// PollInterval is the interval between polls for an updated entity.
+	// Defaults to 1 second.
+	PollInterval time.Duration
+}
+
+// RefreshEntityAndWait refreshes an entity and waits until the refresh is visible in the catalog.
+//
+// The refresh is considered visible when the entity's ETag changes. Since the ETag only changes when the stored entity
+// changes, refreshing an unmodified entity will wait until the context is done. Use a context deadline to bound
+// the wait.
+func (c *Client) RefreshEntityAndWait(ctx context.Context, request *RefreshEntityAndWaitRequest) (*Entity, error) {
+	entityRef, err := ParseEntityRef(request.EntityRef, EntityRefDefaults{})
+	if err != nil {
+		return nil, err
+	}
+	getRequest := &GetEntityByNameRequest{
+		Kind:      entityRef.Kind,
+		Namespace: entityRef.Namespace,
+		Name:      entityRef.Name,
+	}
+	pollInterval := request.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	entity, err := c.GetEntityByName(ctx, getRequest)
+	if err != nil {
+		return nil, err
+	}
+	initialETag := entity.Metadata.ETag
+	if err := c.RefreshEntity(ctx, &RefreshEntityRequest{EntityRef: request.EntityRef}); err != nil {
+		return nil, err
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for refresh of %s: %w", request.EntityRef, ctx.Err())
+		case <-ticker.C:
+		}
+		entity, err := c.GetEntityByName(ctx, getRequest)
+		if err != nil {
+			return nil, err
+		}
+		if entity.Metadata.ETag != initialETag {
+			return entity, nil
+		}
+	}
+}
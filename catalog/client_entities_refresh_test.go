@@ -0,0 +1,98 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_RefreshEntity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/catalog/refresh", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("content-type"))
+			body, err := io.ReadAll(r.Body)
+			assert.NilError(t, err)
+			assert.NilError(t, r.Body.Close())
+			assert.Equal(t, `{"entityRef":"component:default/foo"}`, string(body))
+		})
+		assert.NilError(t, client.RefreshEntity(ctx, &RefreshEntityRequest{EntityRef: "component:default/foo"}))
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusNotFound
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/catalog/refresh", r.URL.Path)
+			w.WriteHeader(statusCode)
+		})
+		err := client.RefreshEntity(ctx, &RefreshEntityRequest{EntityRef: "component:default/foo"})
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
+
+func TestClient_RefreshEntityAndWait(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		var refreshed atomic.Bool
+		var polls atomic.Int64
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/catalog/refresh":
+				assert.Equal(t, http.MethodPost, r.Method)
+				refreshed.Store(true)
+			case "/api/catalog/entities/by-name/component/default/foo":
+				assert.Equal(t, http.MethodGet, r.Method)
+				if refreshed.Load() && polls.Add(1) > 2 {
+					_, _ = w.Write([]byte(`{"kind":"Component","metadata":{"name":"foo","etag":"new"}}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"kind":"Component","metadata":{"name":"foo","etag":"old"}}`))
+			default:
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+		})
+		entity, err := client.RefreshEntityAndWait(ctx, &RefreshEntityAndWaitRequest{
+			EntityRef:    "component:foo",
+			PollInterval: time.Millisecond,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, "new", entity.Metadata.ETag)
+		assert.Equal(t, int64(3), polls.Load())
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/catalog/entities/by-name/component/default/foo" {
+				_, _ = w.Write([]byte(`{"kind":"Component","metadata":{"name":"foo","etag":"old"}}`))
+			}
+		})
+		ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		entity, err := client.RefreshEntityAndWait(ctx, &RefreshEntityAndWaitRequest{
+			EntityRef:    "component:default/foo",
+			PollInterval: time.Millisecond,
+		})
+		assert.Assert(t, entity == nil)
+		assert.Assert(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("invalid entity ref", func(t *testing.T) {
+		client := newTestClient(t, func(http.ResponseWriter, *http.Request) {
+			t.Error("unexpected request")
+		})
+		_, err := client.RefreshEntityAndWait(ctx, &RefreshEntityAndWaitRequest{EntityRef: "foo"})
+		assert.ErrorContains(t, err, "missing kind")
+	})
+}
@@ -0,0 +1,71 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.einride.tech/backstage/internal/backstagehttp"
+)
+
+// ValidateEntityRequest is the request to the [Client.ValidateEntity] method.
+type ValidateEntityRequest struct {
+	// Entity JSON to validate.
+	Entity json.RawMessage `json:"entity"`
+
+	// Location reference of the entity, on the format <type>:<target>, e.g. "url:https://example.com/catalog-info.yaml".
+	Location string `json:"location"`
+}
+
+// ValidateEntityResponse is the response from the [Client.ValidateEntity] method.
+type ValidateEntityResponse struct {
+	// Valid is true if the entity passed validation.
+	Valid bool
+
+	// Errors found when validating the entity.
+	Errors []*SerializedError
+}
+
+// ValidateEntity validates an entity using the catalog's processors, without storing it in the catalog.
+//
+// An entity failing validation is not an error, and is reported in the [ValidateEntityResponse].
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#post-validate-entity
+func (c *Client) ValidateEntity(
+	ctx context.Context,
+	request *ValidateEntityRequest,
+) (*ValidateEntityResponse, error) {
+	const path = "/api/catalog/validate-entity"
+	var response ValidateEntityResponse
+	if err := c.client.Send(
+		ctx,
+		http.MethodPost,
+		path,
+		nil,
+		request,
+		[]int{http.StatusOK, http.StatusBadRequest},
+		func(httpResponse *http.Response) error {
+			if httpResponse.StatusCode == http.StatusOK {
+				response.Valid = true
+				return nil
+			}
+			body, err := io.ReadAll(io.LimitReader(httpResponse.Body, backstagehttp.MaxErrorBodySize))
+			if err != nil {
+				return err
+			}
+			var responseBody struct {
+				Errors []*SerializedError `json:"errors"`
+			}
+			if err := json.Unmarshal(body, &responseBody); err != nil || len(responseBody.Errors) == 0 {
+				// Not a validation result, e.g. a malformed request.
+				return backstagehttp.NewStatusError(httpResponse, body)
+			}
+			response.Errors = responseBody.Errors
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,92 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"go.einride.tech/backstage/internal/backstagehttp"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_ValidateEntity(t *testing.T) {
+	ctx := context.Background()
+	const entity = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo"}}`
+
+	t.Run("valid", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/catalog/validate-entity", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("content-type"))
+			body, err := io.ReadAll(r.Body)
+			assert.NilError(t, err)
+			assert.NilError(t, r.Body.Close())
+			assert.Equal(t, `{"entity":`+entity+`,"location":"url:https://example.com/catalog-info.yaml"}`, string(body))
+		})
+		actual, err := client.ValidateEntity(ctx, &ValidateEntityRequest{
+			Entity:   json.RawMessage(entity),
+			Location: "url:https://example.com/catalog-info.yaml",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, &ValidateEntityResponse{Valid: true}, actual)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		expected := &ValidateEntityResponse{
+			Errors: []*SerializedError{
+				{Name: "InputError", Message: "Policy check failed for component:default/foo"},
+			},
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/catalog/validate-entity", r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(
+				`{"errors":[{"name":"InputError","message":"Policy check failed for component:default/foo"}]}`,
+			))
+		})
+		actual, err := client.ValidateEntity(ctx, &ValidateEntityRequest{
+			Entity:   json.RawMessage(entity),
+			Location: "url:https://example.com/catalog-info.yaml",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+		assert.Equal(t, "InputError: Policy check failed for component:default/foo", actual.Errors[0].Error())
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusBadRequest
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/catalog/validate-entity", r.URL.Path)
+			w.WriteHeader(statusCode)
+			_, _ = w.Write([]byte(`{"error":{"name":"InputError","message":"Malformed request"}}`))
+		})
+		response, err := client.ValidateEntity(ctx, &ValidateEntityRequest{
+			Entity:   json.RawMessage(entity),
+			Location: "url:https://example.com/catalog-info.yaml",
+		})
+		assert.Assert(t, response == nil)
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+
+	t.Run("oversized body", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			// A validation result larger than the max error body size is truncated, and not parsed.
+			_, _ = w.Write([]byte(`{"errors":[{"name":"InputError","message":"`))
+			_, _ = w.Write(bytes.Repeat([]byte("x"), backstagehttp.MaxErrorBodySize))
+			_, _ = w.Write([]byte(`"}]}`))
+		})
+		response, err := client.ValidateEntity(ctx, &ValidateEntityRequest{
+			Entity:   json.RawMessage(entity),
+			Location: "url:https://example.com/catalog-info.yaml",
+		})
+		assert.Assert(t, response == nil)
+		assert.ErrorIs(t, err, ErrBadRequest)
+	})
+}
@@ -0,0 +1,93 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// AnalyzeLocationRequest is the request to the [Client.AnalyzeLocation] method.
+type AnalyzeLocationRequest struct {
+	// Location to analyze.
+	Location *LocationSpec `json:"location"`
+
+	// CatalogFilename is the name of the entity file to look for. Defaults to catalog-info.yaml.
+	CatalogFilename string `json:"catalogFilename,omitempty"`
+}
+
+// AnalyzeLocationResponse is the response from the [Client.AnalyzeLocation] method.
+type AnalyzeLocationResponse struct {
+	// ExistingEntityFiles are entity files already present in the location.
+	ExistingEntityFiles []*AnalyzeLocationExistingEntity `json:"existingEntityFiles"`
+
+	// GenerateEntities are entities that the catalog suggests generating for the location.
+	GenerateEntities []*AnalyzeLocationGenerateEntity `json:"generateEntities"`
+}
+
+// AnalyzeLocationExistingEntity is an existing entity file found when analyzing a location.
+type AnalyzeLocationExistingEntity struct {
+	// Location of the entity file.
+	Location *LocationSpec `json:"location"`
+
+	// IsRegistered is true if the entity file is already registered in the catalog.
+	IsRegistered bool `json:"isRegistered"`
+
+	// Entity found in the entity file.
+	Entity *Entity `json:"entity"`
+}
+
+// AnalyzeLocationGenerateEntity is an entity suggested to be generated when analyzing a location.
+type AnalyzeLocationGenerateEntity struct {
+	// Entity is the partial entity suggested by the analysis.
+	Entity *Entity `json:"entity"`
+
+	// Fields of the entity that have been analyzed or need user input.
+	Fields []*AnalyzeLocationEntityField `json:"fields"`
+}
+
+// AnalyzeLocationEntityField is a field of an entity suggested to be generated when analyzing a location.
+type AnalyzeLocationEntityField struct {
+	// Field is the path to the field in the entity, e.g. "spec.owner".
+	Field string `json:"field"`
+
+	// State of the field.
+	State AnalyzeLocationEntityFieldState `json:"state"`
+
+	// Value of the field, if any.
+	Value string `json:"value"`
+
+	// Description of the field.
+	Description string `json:"description"`
+}
+
+// AnalyzeLocationEntityFieldState represents the analysis state of an entity field.
+type AnalyzeLocationEntityFieldState string
+
+// Known AnalyzeLocationEntityFieldState values.
+const (
+	// AnalyzeLocationEntityFieldStateSuggestedValue is a field with a value suggested by the analysis.
+	AnalyzeLocationEntityFieldStateSuggestedValue AnalyzeLocationEntityFieldState = "analysisSuggestedValue"
+
+	// AnalyzeLocationEntityFieldStateSuggestedNoValue is a field that the analysis suggests leaving empty.
+	AnalyzeLocationEntityFieldStateSuggestedNoValue AnalyzeLocationEntityFieldState = "analysisSuggestedNoValue"
+
+	// AnalyzeLocationEntityFieldStateNeedsUserInput is a field that needs a value supplied by the user.
+	AnalyzeLocationEntityFieldStateNeedsUserInput AnalyzeLocationEntityFieldState = "needsUserInput"
+)
+
+// AnalyzeLocation analyzes a location, such as a repository URL, and returns what the catalog would import from it.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#post-analyze-location
+func (c *Client) AnalyzeLocation(
+	ctx context.Context,
+	request *AnalyzeLocationRequest,
+) (*AnalyzeLocationResponse, error) {
+	const path = "/api/catalog/analyze-location"
+	var response AnalyzeLocationResponse
+	if err := c.post(ctx, path, nil, request, func(r *http.Response) error {
+		return json.NewDecoder(r.Body).Decode(&response)
+	}); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,97 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_AnalyzeLocation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		const (
+			existing  = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo"}}`
+			generated = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"bar"}}`
+		)
+		expected := &AnalyzeLocationResponse{
+			ExistingEntityFiles: []*AnalyzeLocationExistingEntity{
+				{
+					Location: &LocationSpec{
+						Type:   "url",
+						Target: "https://github.com/example/foo/blob/main/catalog-info.yaml",
+					},
+					IsRegistered: true,
+					Entity: &Entity{
+						APIVersion: "backstage.io/v1alpha1",
+						Kind:       EntityKindComponent,
+						Metadata:   EntityMetadata{Name: "foo"},
+						Raw:        json.RawMessage(existing),
+					},
+				},
+			},
+			GenerateEntities: []*AnalyzeLocationGenerateEntity{
+				{
+					Entity: &Entity{
+						APIVersion: "backstage.io/v1alpha1",
+						Kind:       EntityKindComponent,
+						Metadata:   EntityMetadata{Name: "bar"},
+						Raw:        json.RawMessage(generated),
+					},
+					Fields: []*AnalyzeLocationEntityField{
+						{
+							Field:       "spec.owner",
+							State:       AnalyzeLocationEntityFieldStateNeedsUserInput,
+							Description: "Entity owner",
+						},
+					},
+				},
+			},
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/catalog/analyze-location", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("content-type"))
+			body, err := io.ReadAll(r.Body)
+			assert.NilError(t, err)
+			assert.NilError(t, r.Body.Close())
+			assert.Equal(t, `{"location":{"type":"url","target":"https://github.com/example/foo"}}`, string(body))
+			_, _ = w.Write([]byte(fmt.Sprintf(
+				`{"existingEntityFiles":[{"location":{"type":"url",`+
+					`"target":"https://github.com/example/foo/blob/main/catalog-info.yaml"},`+
+					`"isRegistered":true,"entity":%s}],`+
+					`"generateEntities":[{"entity":%s,"fields":[{"field":"spec.owner","state":"needsUserInput",`+
+					`"value":null,"description":"Entity owner"}]}]}`,
+				existing,
+				generated,
+			)))
+		})
+		actual, err := client.AnalyzeLocation(ctx, &AnalyzeLocationRequest{
+			Location: &LocationSpec{Type: "url", Target: "https://github.com/example/foo"},
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusBadRequest
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/catalog/analyze-location", r.URL.Path)
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.AnalyzeLocation(ctx, &AnalyzeLocationRequest{
+			Location: &LocationSpec{Type: "url", Target: "https://github.com/example/foo"},
+		})
+		assert.Assert(t, response == nil)
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
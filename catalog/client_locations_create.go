@@ -0,0 +1,63 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// CreateLocationRequest is the request to the [Client.CreateLocation] method.
+type CreateLocationRequest struct {
+	// Type of the location, e.g. "url".
+	Type string `json:"type"`
+
+	// Target of the location, e.g. the URL to a catalog-info.yaml file.
+	Target string `json:"target"`
+
+	// DryRun validates the location and returns the entities it would produce, without registering it.
+	DryRun bool `json:"-"`
+}
+
+// CreateLocationResponse is the response from the [Client.CreateLocation] method.
+type CreateLocationResponse struct {
+	// Location that was created.
+	Location *Location
+	// Entities read from the location.
+	//
+	// Only populated in dry-run mode.
+	Entities []*Entity
+	// Exists is true if the location already existed.
+	//
+	// Only populated in dry-run mode.
+	Exists bool
+}
+
+// CreateLocation registers a new location in the catalog.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#post-locations
+func (c *Client) CreateLocation(
+	ctx context.Context,
+	request *CreateLocationRequest,
+) (*CreateLocationResponse, error) {
+	const path = "/api/catalog/locations"
+	query := make(url.Values)
+	if request.DryRun {
+		query.Set("dryRun", "true")
+	}
+	var responseBody struct {
+		Location *Location `json:"location"`
+		Entities []*Entity `json:"entities"`
+		Exists   bool      `json:"exists"`
+	}
+	if err := c.post(ctx, path, query, request, func(response *http.Response) error {
+		return json.NewDecoder(response.Body).Decode(&responseBody)
+	}); err != nil {
+		return nil, err
+	}
+	return &CreateLocationResponse{
+		Location: responseBody.Location,
+		Entities: responseBody.Entities,
+		Exists:   responseBody.Exists,
+	}, nil
+}
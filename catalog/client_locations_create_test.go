@@ -0,0 +1,97 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_CreateLocation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		expected := &CreateLocationResponse{
+			Location: &Location{ID: "1", Type: "url", Target: "https://example.com/catalog-info.yaml"},
+			Entities: []*Entity{},
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/catalog/locations", r.URL.Path)
+			assert.Equal(t, "", r.URL.RawQuery)
+			assert.Equal(t, "application/json", r.Header.Get("content-type"))
+			body, err := io.ReadAll(r.Body)
+			assert.NilError(t, err)
+			assert.NilError(t, r.Body.Close())
+			assert.Equal(t, `{"type":"url","target":"https://example.com/catalog-info.yaml"}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(
+				`{"location":{"id":"1","type":"url","target":"https://example.com/catalog-info.yaml"},"entities":[]}`,
+			))
+		})
+		actual, err := client.CreateLocation(ctx, &CreateLocationRequest{
+			Type:   "url",
+			Target: "https://example.com/catalog-info.yaml",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		const system = `{"apiVersion":"backstage.io/v1alpha1","kind":"System","metadata":{"name":"system1"}}`
+		expected := &CreateLocationResponse{
+			Location: &Location{ID: "1", Type: "url", Target: "https://example.com/catalog-info.yaml"},
+			Entities: []*Entity{
+				{
+					APIVersion: "backstage.io/v1alpha1",
+					Kind:       EntityKindSystem,
+					Metadata: EntityMetadata{
+						Name: "system1",
+					},
+					Raw: json.RawMessage(system),
+				},
+			},
+			Exists: true,
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/catalog/locations", r.URL.Path)
+			assert.Equal(t, "true", r.URL.Query().Get("dryRun"))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(fmt.Sprintf(
+				`{"location":{"id":"1","type":"url","target":"https://example.com/catalog-info.yaml"},`+
+					`"entities":[%s],"exists":true}`,
+				system,
+			)))
+		})
+		actual, err := client.CreateLocation(ctx, &CreateLocationRequest{
+			Type:   "url",
+			Target: "https://example.com/catalog-info.yaml",
+			DryRun: true,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusConflict
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/catalog/locations", r.URL.Path)
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.CreateLocation(ctx, &CreateLocationRequest{
+			Type:   "url",
+			Target: "https://example.com/catalog-info.yaml",
+		})
+		assert.Assert(t, response == nil)
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
@@ -0,0 +1,21 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DeleteLocationByIDRequest is the request to the [Client.DeleteLocationByID] method.
+type DeleteLocationByIDRequest struct {
+	// ID of the location to delete.
+	ID string
+}
+
+// DeleteLocationByID deletes a location by its ID.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#delete-locationsid
+func (c *Client) DeleteLocationByID(ctx context.Context, request *DeleteLocationByIDRequest) error {
+	const pathTemplate = "/api/catalog/locations/%s"
+	return c.delete(ctx, fmt.Sprintf(pathTemplate, url.PathEscape(request.ID)))
+}
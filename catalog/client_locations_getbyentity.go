@@ -0,0 +1,39 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetLocationByEntityRequest is the request to the [Client.GetLocationByEntity] method.
+type GetLocationByEntityRequest struct {
+	// Kind of the entity to get the location for.
+	Kind string
+	// Namespace of the entity to get the location for.
+	Namespace string
+	// Name of the entity to get the location for.
+	Name string
+}
+
+// GetLocationByEntity gets the location that an entity originates from, by the entity's kind, namespace and name.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#locations
+func (c *Client) GetLocationByEntity(ctx context.Context, request *GetLocationByEntityRequest) (*Location, error) {
+	const pathTemplate = "/api/catalog/locations/by-entity/%s/%s/%s"
+	path := fmt.Sprintf(
+		pathTemplate,
+		url.PathEscape(request.Kind),
+		url.PathEscape(request.Namespace),
+		url.PathEscape(request.Name),
+	)
+	var location Location
+	if err := c.get(ctx, path, nil, func(response *http.Response) error {
+		return json.NewDecoder(response.Body).Decode(&location)
+	}); err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
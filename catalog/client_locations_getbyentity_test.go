@@ -0,0 +1,48 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_GetLocationByEntity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		expected := &Location{ID: "1", Type: "url", Target: "https://example.com/catalog-info.yaml"}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/locations/by-entity/foo/bar/baz", r.URL.Path)
+			_, _ = w.Write([]byte(`{"id":"1","type":"url","target":"https://example.com/catalog-info.yaml"}`))
+		})
+		actual, err := client.GetLocationByEntity(ctx, &GetLocationByEntityRequest{
+			Kind:      "foo",
+			Namespace: "bar",
+			Name:      "baz",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusNotFound
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/locations/by-entity/foo/bar/baz", r.URL.Path)
+			w.WriteHeader(statusCode)
+		})
+		location, err := client.GetLocationByEntity(ctx, &GetLocationByEntityRequest{
+			Kind:      "foo",
+			Namespace: "bar",
+			Name:      "baz",
+		})
+		assert.Assert(t, location == nil)
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
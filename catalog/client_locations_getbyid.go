@@ -0,0 +1,30 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetLocationByIDRequest is the request to the [Client.GetLocationByID] method.
+type GetLocationByIDRequest struct {
+	// ID of the location to get.
+	ID string
+}
+
+// GetLocationByID gets a location by its ID.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#get-locationsid
+func (c *Client) GetLocationByID(ctx context.Context, request *GetLocationByIDRequest) (*Location, error) {
+	const pathTemplate = "/api/catalog/locations/%s"
+	path := fmt.Sprintf(pathTemplate, url.PathEscape(request.ID))
+	var location Location
+	if err := c.get(ctx, path, nil, func(response *http.Response) error {
+		return json.NewDecoder(response.Body).Decode(&location)
+	}); err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
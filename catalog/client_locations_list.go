@@ -0,0 +1,36 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// ListLocationsRequest is the request to the [Client.ListLocations] method.
+type ListLocationsRequest struct{}
+
+// ListLocationsResponse is the response from the [Client.ListLocations] method.
+type ListLocationsResponse struct {
+	// Locations in the response.
+	Locations []*Location
+}
+
+// ListLocations lists all locations registered in the catalog.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#get-locations
+func (c *Client) ListLocations(ctx context.Context, _ *ListLocationsRequest) (*ListLocationsResponse, error) {
+	const path = "/api/catalog/locations"
+	var responseBody []struct {
+		Data *Location `json:"data"`
+	}
+	if err := c.get(ctx, path, nil, func(response *http.Response) error {
+		return json.NewDecoder(response.Body).Decode(&responseBody)
+	}); err != nil {
+		return nil, err
+	}
+	locations := make([]*Location, 0, len(responseBody))
+	for _, item := range responseBody {
+		locations = append(locations, item.Data)
+	}
+	return &ListLocationsResponse{Locations: locations}, nil
+}
@@ -0,0 +1,47 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_ListLocations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		expected := &ListLocationsResponse{
+			Locations: []*Location{
+				{ID: "1", Type: "url", Target: "https://example.com/foo/catalog-info.yaml"},
+				{ID: "2", Type: "url", Target: "https://example.com/bar/catalog-info.yaml"},
+			},
+		}
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/locations", r.URL.Path)
+			_, _ = w.Write([]byte(`[` +
+				`{"data":{"id":"1","type":"url","target":"https://example.com/foo/catalog-info.yaml"}},` +
+				`{"data":{"id":"2","type":"url","target":"https://example.com/bar/catalog-info.yaml"}}]`))
+		})
+		actual, err := client.ListLocations(ctx, &ListLocationsRequest{})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, expected, actual)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusInternalServerError
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/locations", r.URL.Path)
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.ListLocations(ctx, &ListLocationsRequest{})
+		assert.Assert(t, response == nil)
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
@@ -2,9 +2,13 @@ package catalog
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 )
@@ -27,9 +31,192 @@ func TestNewClient(t *testing.T) {
 		assert.NilError(t, err)
 		assert.Equal(t, "Bearer "+testToken, authorization)
 	})
+
+	t.Run("empty token", func(t *testing.T) {
+		authorization := "unset"
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("authorization")
+			_, _ = w.Write([]byte("{}"))
+		}))
+		t.Cleanup(server.Close)
+		client := NewClient(WithBaseURL(server.URL), WithToken(""))
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.NilError(t, err)
+		assert.Equal(t, "", authorization)
+	})
+
+	t.Run("default client not modified", func(t *testing.T) {
+		defaultTransport := http.DefaultClient.Transport
+		client := NewClient(WithToken(testToken))
+		assert.Assert(t, client.client.HTTPClient() != http.DefaultClient)
+		assert.Equal(t, defaultTransport, http.DefaultClient.Transport)
+	})
+
+	t.Run("with HTTP client", func(t *testing.T) {
+		var authorization, userAgent string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("authorization")
+			userAgent = r.Header.Get("user-agent")
+			_, _ = w.Write([]byte("{}"))
+		}))
+		t.Cleanup(server.Close)
+		httpClient := &http.Client{
+			Transport: &userAgentRoundTripper{userAgent: "test", next: http.DefaultTransport},
+			Timeout:   time.Minute,
+		}
+		originalTransport := httpClient.Transport
+		client := NewClient(
+			WithBaseURL(server.URL),
+			WithToken(testToken),
+			WithHTTPClient(httpClient),
+		)
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.NilError(t, err)
+		assert.Equal(t, "Bearer "+testToken, authorization)
+		assert.Equal(t, "test", userAgent)
+		assert.Equal(t, time.Minute, client.client.HTTPClient().Timeout)
+		assert.Equal(t, originalTransport, httpClient.Transport)
+	})
+
+	t.Run("with transport", func(t *testing.T) {
+		var authorization, userAgent string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("authorization")
+			userAgent = r.Header.Get("user-agent")
+			_, _ = w.Write([]byte("{}"))
+		}))
+		t.Cleanup(server.Close)
+		client := NewClient(
+			WithBaseURL(server.URL),
+			WithToken(testToken),
+			WithHTTPClient(&http.Client{Timeout: time.Minute}),
+			WithTransport(&userAgentRoundTripper{userAgent: "test", next: http.DefaultTransport}),
+		)
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.NilError(t, err)
+		assert.Equal(t, "Bearer "+testToken, authorization)
+		assert.Equal(t, "test", userAgent)
+		assert.Equal(t, time.Minute, client.client.HTTPClient().Timeout)
+	})
+}
+
+type userAgentRoundTripper struct {
+	userAgent string
+	next      http.RoundTripper
+}
+
+func (u *userAgentRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	request.Header.Set("User-Agent", u.userAgent)
+	return u.next.RoundTrip(request)
+}
+
+func TestNewClient_tokenSource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("called for every request", func(t *testing.T) {
+		var authorizations []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorizations = append(authorizations, r.Header.Get("authorization"))
+			_, _ = w.Write([]byte("{}"))
+		}))
+		t.Cleanup(server.Close)
+		var count int
+		client := NewClient(
+			WithBaseURL(server.URL),
+			WithTokenSource(TokenSourceFunc(func(context.Context) (string, error) {
+				count++
+				return fmt.Sprintf("token%d", count), nil
+			})),
+		)
+		for range 2 {
+			_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+			assert.NilError(t, err)
+		}
+		assert.DeepEqual(t, []string{"Bearer token1", "Bearer token2"}, authorizations)
+	})
+
+	t.Run("empty token", func(t *testing.T) {
+		authorization := "unset"
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("authorization")
+			_, _ = w.Write([]byte("{}"))
+		}))
+		t.Cleanup(server.Close)
+		client := NewClient(
+			WithBaseURL(server.URL),
+			WithTokenSource(TokenSourceFunc(func(context.Context) (string, error) {
+				return "", nil
+			})),
+		)
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.NilError(t, err)
+		assert.Equal(t, "", authorization)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		client := NewClient(
+			WithBaseURL("http://localhost"),
+			WithTokenSource(TokenSourceFunc(func(context.Context) (string, error) {
+				return "", errors.New("boom")
+			})),
+		)
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.ErrorContains(t, err, "get token: boom")
+	})
+
+	t.Run("retry once on unauthorized", func(t *testing.T) {
+		var authorizations, bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorizations = append(authorizations, r.Header.Get("authorization"))
+			body, err := io.ReadAll(r.Body)
+			assert.NilError(t, err)
+			bodies = append(bodies, string(body))
+			if r.Header.Get("authorization") != "Bearer fresh" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"items":[]}`))
+		}))
+		t.Cleanup(server.Close)
+		tokenSource := &invalidatingTokenSource{tokens: []string{"stale", "fresh"}}
+		client := NewClient(WithBaseURL(server.URL), WithTokenSource(tokenSource))
+		_, err := client.BatchGetEntitiesByRefs(ctx, &BatchGetEntitiesByRefsRequest{EntityRefs: []string{"foo"}})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, []string{"Bearer stale", "Bearer fresh"}, authorizations)
+		assert.DeepEqual(t, []string{`{"entityRefs":["foo"]}`, `{"entityRefs":["foo"]}`}, bodies)
+		assert.Equal(t, 1, tokenSource.invalidations)
+	})
+
+	t.Run("no retry with unchanged token", func(t *testing.T) {
+		var count int
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			count++
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, http.StatusUnauthorized, errStatus.StatusCode)
+		assert.Equal(t, 1, count)
+	})
+}
+
+type invalidatingTokenSource struct {
+	tokens        []string
+	invalidations int
+}
+
+func (s *invalidatingTokenSource) Token(context.Context) (string, error) {
+	return s.tokens[0], nil
+}
+
+func (s *invalidatingTokenSource) InvalidateToken() {
+	s.invalidations++
+	s.tokens = s.tokens[1:]
 }
 
-func newTestClient(t *testing.T, handler func(http.ResponseWriter, *http.Request)) *Client {
+func newTestClient(t testing.TB, handler func(http.ResponseWriter, *http.Request)) *Client {
 	server := httptest.NewServer(http.HandlerFunc(handler))
 	t.Cleanup(server.Close)
 	return NewClient(
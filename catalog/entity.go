@@ -3,6 +3,7 @@ package catalog
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 )
 
 // An Entity in the software catalog.
@@ -16,32 +17,111 @@ type Entity struct {
 	// Metadata related to the entity.
 	Metadata EntityMetadata
 
+	// Spec is the raw JSON of the entity's kind-specific spec, if any.
+	Spec json.RawMessage
+
 	// Relations that this entity has with other entities.
 	Relations []EntityRelation
 
+	// Status of the entity, as reported by the catalog, if any.
+	Status *EntityStatus
+
 	// Raw entity JSON message.
 	Raw json.RawMessage
 }
 
+// entityFields contains the typed fields of an [Entity].
+type entityFields struct {
+	APIVersion string           `json:"apiVersion"`
+	Kind       EntityKind       `json:"kind"`
+	Metadata   EntityMetadata   `json:"metadata"`
+	Spec       json.RawMessage  `json:"spec,omitempty"`
+	Relations  []EntityRelation `json:"relations,omitempty"`
+	Status     *EntityStatus    `json:"status,omitempty"`
+}
+
 // UnmarshalJSON implements [json.Unmarshaler].
 func (e *Entity) UnmarshalJSON(data []byte) error {
-	var fields struct {
-		APIVersion string           `json:"apiVersion"`
-		Kind       EntityKind       `json:"kind"`
-		Metadata   EntityMetadata   `json:"metadata"`
-		Relations  []EntityRelation `json:"relations,omitempty"`
-	}
+	var fields entityFields
 	if err := json.Unmarshal(data, &fields); err != nil {
 		return err
 	}
 	e.APIVersion = fields.APIVersion
 	e.Kind = fields.Kind
 	e.Metadata = fields.Metadata
+	e.Spec = fields.Spec
 	e.Relations = fields.Relations
+	e.Status = fields.Status
 	e.Raw = data
 	return nil
 }
 
+// MarshalJSON implements [json.Marshaler].
+//
+// The typed fields of the entity are merged into the raw entity JSON, so that unknown fields in the raw JSON are
+// preserved. Annotations, labels, links, relations and status are only taken from the typed fields when they have been
+// modified, so that unknown fields and the order of keys in the raw JSON are preserved.
+func (e *Entity) MarshalJSON() ([]byte, error) {
+	object, err := parseJSONObject(e.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("marshal entity: %w", err)
+	}
+	var rawFields entityFields
+	if len(e.Raw) > 0 {
+		if err := json.Unmarshal(e.Raw, &rawFields); err != nil {
+			return nil, fmt.Errorf("marshal entity: %w", err)
+		}
+	}
+	if err := object.setValue("apiVersion", e.APIVersion); err != nil {
+		return nil, fmt.Errorf("marshal entity: %w", err)
+	}
+	if err := object.setValue("kind", e.Kind); err != nil {
+		return nil, fmt.Errorf("marshal entity: %w", err)
+	}
+	metadata, err := parseJSONObject(object.get("metadata"))
+	if err != nil {
+		return nil, fmt.Errorf("marshal entity: metadata: %w", err)
+	}
+	rawAnnotations, rawLabels, rawLinks := metadata.get("annotations"), metadata.get("labels"), metadata.get("links")
+	if err := metadata.mergeStruct(e.Metadata); err != nil {
+		return nil, fmt.Errorf("marshal entity: metadata: %w", err)
+	}
+	if len(rawAnnotations) > 0 && reflect.DeepEqual(e.Metadata.Annotations, rawFields.Metadata.Annotations) {
+		metadata.set("annotations", rawAnnotations)
+	}
+	if len(rawLabels) > 0 && reflect.DeepEqual(e.Metadata.Labels, rawFields.Metadata.Labels) {
+		metadata.set("labels", rawLabels)
+	}
+	if len(rawLinks) > 0 && reflect.DeepEqual(e.Metadata.Links, rawFields.Metadata.Links) {
+		metadata.set("links", rawLinks)
+	}
+	if err := object.setValue("metadata", metadata); err != nil {
+		return nil, fmt.Errorf("marshal entity: %w", err)
+	}
+	if len(e.Spec) > 0 {
+		object.set("spec", e.Spec)
+	} else {
+		object.delete("spec")
+	}
+	switch {
+	case len(e.Relations) == 0:
+		object.delete("relations")
+	case !reflect.DeepEqual(e.Relations, rawFields.Relations):
+		if err := object.setValue("relations", e.Relations); err != nil {
+			return nil, fmt.Errorf("marshal entity: %w", err)
+		}
+	}
+	switch {
+	case e.Status == nil:
+		object.delete("status")
+	case !reflect.DeepEqual(e.Status, rawFields.Status):
+		if err := object.setValue("status", e.Status); err != nil {
+			return nil, fmt.Errorf("marshal entity: %w", err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // APISpec decodes the entity's spec as a [APISpec].
 func (e *Entity) APISpec() (*APISpec, error) {
 	return unmarshalSpec[APISpec](EntityKindAPI, e)
@@ -91,11 +171,5 @@ func unmarshalSpec[T any](kind EntityKind, e *Entity) (*T, error) {
 	if e.Kind != kind {
 		return nil, fmt.Errorf("expected kind %s but was %s", kind, e.Kind)
 	}
-	var result struct {
-		Spec *T `json:"spec"`
-	}
-	if err := json.Unmarshal(e.Raw, &result); err != nil {
-		return nil, err
-	}
-	return result.Spec, nil
+	return DecodeSpec[T](e)
 }
@@ -75,7 +75,7 @@ type EntityMetadata struct {
 // EntityRelation is a relation of a specific type to another entity in the catalog.
 type EntityRelation struct {
 	// The type of the relation.
-	Type string `json:"type"`
+	Type RelationType `json:"type"`
 
 	// The entity ref of the target of this relation.
 	TargetRef string `json:"targetRef"`
@@ -0,0 +1,132 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultNamespace is the namespace of entities that don't specify a namespace.
+const DefaultNamespace = "default"
+
+// EntityRef is a reference to an entity in the catalog, by its kind, namespace and name.
+//
+// See: https://backstage.io/docs/features/software-catalog/references
+type EntityRef struct {
+	// Kind of the referenced entity.
+	Kind string
+	// Namespace of the referenced entity.
+	Namespace string
+	// Name of the referenced entity.
+	Name string
+}
+
+// EntityRefDefaults contains default values for parts left out of a string entity reference.
+type EntityRefDefaults struct {
+	// Kind to use when the reference has no kind.
+	Kind string
+	// Namespace to use when the reference has no namespace. Defaults to [DefaultNamespace].
+	Namespace string
+}
+
+// ParseEntityRef parses a string entity reference on the format [<kind>:][<namespace>/]<name>.
+//
+// Parts left out of the reference are taken from the provided defaults. A kind is required, either in the reference or
+// in the defaults. The namespace defaults to [DefaultNamespace].
+func ParseEntityRef(s string, defaults EntityRefDefaults) (EntityRef, error) {
+	ref, err := parseEntityRef(s, defaults)
+	if err != nil {
+		return EntityRef{}, err
+	}
+	if ref.Kind == "" {
+		return EntityRef{}, fmt.Errorf("parse entity ref %q: missing kind", s)
+	}
+	return ref, nil
+}
+
+func parseEntityRef(s string, defaults EntityRefDefaults) (EntityRef, error) {
+	colonIndex := strings.IndexByte(s, ':')
+	slashIndex := strings.IndexByte(s, '/')
+	// A slash before the colon means the colon is part of the name.
+	if slashIndex != -1 && slashIndex < colonIndex {
+		colonIndex = -1
+	}
+	result := EntityRef{
+		Kind:      defaults.Kind,
+		Namespace: defaults.Namespace,
+	}
+	if result.Namespace == "" {
+		result.Namespace = DefaultNamespace
+	}
+	rest := s
+	if colonIndex != -1 {
+		result.Kind, rest = s[:colonIndex], s[colonIndex+1:]
+		if result.Kind == "" {
+			return EntityRef{}, fmt.Errorf("parse entity ref %q: empty kind", s)
+		}
+	}
+	if namespace, name, ok := strings.Cut(rest, "/"); ok {
+		if namespace == "" {
+			return EntityRef{}, fmt.Errorf("parse entity ref %q: empty namespace", s)
+		}
+		result.Namespace, rest = namespace, name
+	}
+	if rest == "" {
+		return EntityRef{}, fmt.Errorf("parse entity ref %q: empty name", s)
+	}
+	result.Name = rest
+	return result, nil
+}
+
+// String returns the entity reference on the canonical format <kind>:<namespace>/<name>.
+//
+// The kind and namespace are lower-cased. The kind is left out if empty.
+func (r EntityRef) String() string {
+	namespace := r.Namespace
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	if r.Kind == "" {
+		return strings.ToLower(namespace) + "/" + r.Name
+	}
+	return strings.ToLower(r.Kind) + ":" + strings.ToLower(namespace) + "/" + r.Name
+}
+
+// Equal returns true if the entity references refer to the same entity.
+//
+// Entity references are compared case-insensitively, and an empty namespace is equal to [DefaultNamespace].
+func (r EntityRef) Equal(other EntityRef) bool {
+	return strings.EqualFold(r.String(), other.String())
+}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (r EntityRef) MarshalText() ([]byte, error) {
+	if r.Name == "" {
+		return nil, fmt.Errorf("marshal entity ref: empty name")
+	}
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// Unlike [ParseEntityRef], the kind may be left out, since it's often implied by context, e.g. for spec.owner.
+func (r *EntityRef) UnmarshalText(text []byte) error {
+	ref, err := parseEntityRef(string(text), EntityRefDefaults{})
+	if err != nil {
+		return err
+	}
+	*r = ref
+	return nil
+}
+
+// Ref returns a reference to the entity.
+func (e *Entity) Ref() EntityRef {
+	namespace := e.Metadata.Namespace
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	return EntityRef{
+		Kind:      string(e.Kind),
+		Namespace: namespace,
+		Name:      e.Metadata.Name,
+	}
+}
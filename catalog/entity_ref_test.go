@@ -0,0 +1,115 @@
+package catalog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseEntityRef(t *testing.T) {
+	for _, tt := range []struct {
+		input         string
+		defaults      EntityRefDefaults
+		expected      EntityRef
+		errorContains string
+	}{
+		{
+			input:    "component:default/foo",
+			expected: EntityRef{Kind: "component", Namespace: "default", Name: "foo"},
+		},
+		{
+			input:    "Component:Bar/Foo",
+			expected: EntityRef{Kind: "Component", Namespace: "Bar", Name: "Foo"},
+		},
+		{
+			input:    "component:foo",
+			expected: EntityRef{Kind: "component", Namespace: "default", Name: "foo"},
+		},
+		{
+			input:    "component:foo",
+			defaults: EntityRefDefaults{Namespace: "bar"},
+			expected: EntityRef{Kind: "component", Namespace: "bar", Name: "foo"},
+		},
+		{
+			input:    "foo",
+			defaults: EntityRefDefaults{Kind: "group"},
+			expected: EntityRef{Kind: "group", Namespace: "default", Name: "foo"},
+		},
+		{
+			input:    "bar/foo",
+			defaults: EntityRefDefaults{Kind: "group", Namespace: "baz"},
+			expected: EntityRef{Kind: "group", Namespace: "bar", Name: "foo"},
+		},
+		{
+			input:    "location:default/url:https://example.com",
+			expected: EntityRef{Kind: "location", Namespace: "default", Name: "url:https://example.com"},
+		},
+		{
+			input:    "default/url:foo",
+			defaults: EntityRefDefaults{Kind: "location"},
+			expected: EntityRef{Kind: "location", Namespace: "default", Name: "url:foo"},
+		},
+		{input: "foo", errorContains: "missing kind"},
+		{input: ":foo", errorContains: "empty kind"},
+		{input: "component:/foo", errorContains: "empty namespace"},
+		{input: "component:default/", errorContains: "empty name"},
+		{input: "", defaults: EntityRefDefaults{Kind: "group"}, errorContains: "empty name"},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			actual, err := ParseEntityRef(tt.input, tt.defaults)
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestEntityRef_String(t *testing.T) {
+	assert.Equal(t, "component:bar/Foo", EntityRef{Kind: "Component", Namespace: "Bar", Name: "Foo"}.String())
+	assert.Equal(t, "component:default/foo", EntityRef{Kind: "Component", Name: "foo"}.String())
+	assert.Equal(t, "default/foo", EntityRef{Name: "foo"}.String())
+}
+
+func TestEntityRef_Equal(t *testing.T) {
+	assert.Assert(t, EntityRef{Kind: "Component", Namespace: "Default", Name: "Foo"}.Equal(
+		EntityRef{Kind: "component", Namespace: "default", Name: "foo"},
+	))
+	assert.Assert(t, EntityRef{Kind: "component", Name: "foo"}.Equal(
+		EntityRef{Kind: "component", Namespace: "default", Name: "foo"},
+	))
+	assert.Assert(t, !EntityRef{Kind: "component", Name: "foo"}.Equal(
+		EntityRef{Kind: "api", Name: "foo"},
+	))
+}
+
+func TestEntityRef_JSON(t *testing.T) {
+	var actual struct {
+		Owner     EntityRef   `json:"owner"`
+		DependsOn []EntityRef `json:"dependsOn"`
+	}
+	assert.NilError(t, json.Unmarshal(
+		[]byte(`{"owner":"team-a","dependsOn":["resource:default/db","Component:Bar/Foo"]}`),
+		&actual,
+	))
+	assert.Equal(t, EntityRef{Namespace: "default", Name: "team-a"}, actual.Owner)
+	assert.DeepEqual(t, []EntityRef{
+		{Kind: "resource", Namespace: "default", Name: "db"},
+		{Kind: "Component", Namespace: "Bar", Name: "Foo"},
+	}, actual.DependsOn)
+	data, err := json.Marshal(actual)
+	assert.NilError(t, err)
+	assert.Equal(t, `{"owner":"default/team-a","dependsOn":["resource:default/db","component:bar/Foo"]}`, string(data))
+	assert.ErrorContains(t, json.Unmarshal([]byte(`{"owner":"component:"}`), &actual), "empty name")
+	_, err = json.Marshal(EntityRef{})
+	assert.ErrorContains(t, err, "empty name")
+}
+
+func TestEntity_Ref(t *testing.T) {
+	entity := &Entity{Kind: EntityKindComponent, Metadata: EntityMetadata{Name: "foo"}}
+	assert.Equal(t, EntityRef{Kind: "Component", Namespace: "default", Name: "foo"}, entity.Ref())
+	assert.Equal(t, "component:default/foo", entity.Ref().String())
+}
@@ -0,0 +1,112 @@
+package catalog
+
+// RelationType represents a known type of relation between entities.
+type RelationType string
+
+// Known relation types.
+//
+// Relations come in pairs, where each relation type has an inverse relation type in the opposite direction.
+//
+// See: https://backstage.io/docs/features/software-catalog/well-known-relations
+const (
+	// RelationOwnedBy is a relation to an entity that owns the entity, typically a Group or User.
+	RelationOwnedBy RelationType = "ownedBy"
+	// RelationOwnerOf is the inverse of [RelationOwnedBy].
+	RelationOwnerOf RelationType = "ownerOf"
+
+	// RelationPartOf is a relation to an entity that the entity is a part of, e.g. a System.
+	RelationPartOf RelationType = "partOf"
+	// RelationHasPart is the inverse of [RelationPartOf].
+	RelationHasPart RelationType = "hasPart"
+
+	// RelationConsumesAPI is a relation to an API that the entity consumes.
+	RelationConsumesAPI RelationType = "consumesApi"
+	// RelationAPIConsumedBy is the inverse of [RelationConsumesAPI].
+	RelationAPIConsumedBy RelationType = "apiConsumedBy"
+
+	// RelationProvidesAPI is a relation to an API that the entity provides.
+	RelationProvidesAPI RelationType = "providesApi"
+	// RelationAPIProvidedBy is the inverse of [RelationProvidesAPI].
+	RelationAPIProvidedBy RelationType = "apiProvidedBy"
+
+	// RelationDependsOn is a relation to an entity that the entity depends on.
+	RelationDependsOn RelationType = "dependsOn"
+	// RelationDependencyOf is the inverse of [RelationDependsOn].
+	RelationDependencyOf RelationType = "dependencyOf"
+
+	// RelationParentOf is a relation to a child of the entity, e.g. a child Group.
+	RelationParentOf RelationType = "parentOf"
+	// RelationChildOf is the inverse of [RelationParentOf].
+	RelationChildOf RelationType = "childOf"
+
+	// RelationMemberOf is a relation to a Group that the entity is a member of.
+	RelationMemberOf RelationType = "memberOf"
+	// RelationHasMember is the inverse of [RelationMemberOf].
+	RelationHasMember RelationType = "hasMember"
+)
+
+var inverseRelationTypes = map[RelationType]RelationType{
+	RelationOwnedBy:       RelationOwnerOf,
+	RelationOwnerOf:       RelationOwnedBy,
+	RelationPartOf:        RelationHasPart,
+	RelationHasPart:       RelationPartOf,
+	RelationConsumesAPI:   RelationAPIConsumedBy,
+	RelationAPIConsumedBy: RelationConsumesAPI,
+	RelationProvidesAPI:   RelationAPIProvidedBy,
+	RelationAPIProvidedBy: RelationProvidesAPI,
+	RelationDependsOn:     RelationDependencyOf,
+	RelationDependencyOf:  RelationDependsOn,
+	RelationParentOf:      RelationChildOf,
+	RelationChildOf:       RelationParentOf,
+	RelationMemberOf:      RelationHasMember,
+	RelationHasMember:     RelationMemberOf,
+}
+
+// Inverse returns the relation type in the opposite direction, e.g. [RelationOwnerOf] for [RelationOwnedBy].
+//
+// Returns false if the relation type is not a known relation type.
+func (t RelationType) Inverse() (RelationType, bool) {
+	inverse, ok := inverseRelationTypes[t]
+	return inverse, ok
+}
+
+// TargetEntityRef parses the entity ref of the target of the relation.
+func (r EntityRelation) TargetEntityRef() (EntityRef, error) {
+	return ParseEntityRef(r.TargetRef, EntityRefDefaults{})
+}
+
+// RelationsOfType returns the entity refs of the targets of the entity's relations of the provided type.
+//
+// Relations with malformed target refs are skipped.
+func (e *Entity) RelationsOfType(t RelationType) []EntityRef {
+	var result []EntityRef
+	for _, relation := range e.Relations {
+		if relation.Type != t {
+			continue
+		}
+		if targetRef, err := relation.TargetEntityRef(); err == nil {
+			result = append(result, targetRef)
+		}
+	}
+	return result
+}
+
+// Owners returns the entity refs of the owners of the entity.
+func (e *Entity) Owners() []EntityRef {
+	return e.RelationsOfType(RelationOwnedBy)
+}
+
+// Parts returns the entity refs of the parts of the entity, e.g. the components of a System.
+func (e *Entity) Parts() []EntityRef {
+	return e.RelationsOfType(RelationHasPart)
+}
+
+// PartOf returns the entity refs of the entities that the entity is a part of, e.g. the System of a Component.
+func (e *Entity) PartOf() []EntityRef {
+	return e.RelationsOfType(RelationPartOf)
+}
+
+// DependsOn returns the entity refs of the entities that the entity depends on.
+func (e *Entity) DependsOn() []EntityRef {
+	return e.RelationsOfType(RelationDependsOn)
+}
@@ -0,0 +1,41 @@
+package catalog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRelationType_Inverse(t *testing.T) {
+	for relationType, inverse := range inverseRelationTypes {
+		inverseInverse, ok := inverse.Inverse()
+		assert.Assert(t, ok, inverse)
+		assert.Equal(t, relationType, inverseInverse)
+	}
+	inverse, ok := RelationOwnedBy.Inverse()
+	assert.Assert(t, ok)
+	assert.Equal(t, RelationOwnerOf, inverse)
+	_, ok = RelationType("foo").Inverse()
+	assert.Assert(t, !ok)
+}
+
+func TestEntity_RelationsOfType(t *testing.T) {
+	const component = `{"kind":"Component","metadata":{"name":"foo"},"relations":[
+{"type":"ownedBy","targetRef":"group:default/team-a"},
+{"type":"ownedBy","targetRef":"user:default/jane"},
+{"type":"partOf","targetRef":"system:default/podcast"},
+{"type":"dependsOn","targetRef":"resource:default/podcast-db"},
+{"type":"dependsOn","targetRef":"malformed"}
+]}`
+	var entity Entity
+	assert.NilError(t, json.Unmarshal([]byte(component), &entity))
+	assert.DeepEqual(t, []EntityRef{
+		{Kind: "group", Namespace: "default", Name: "team-a"},
+		{Kind: "user", Namespace: "default", Name: "jane"},
+	}, entity.Owners())
+	assert.DeepEqual(t, []EntityRef{{Kind: "system", Namespace: "default", Name: "podcast"}}, entity.PartOf())
+	assert.DeepEqual(t, []EntityRef{{Kind: "resource", Namespace: "default", Name: "podcast-db"}}, entity.DependsOn())
+	assert.Assert(t, entity.Parts() == nil)
+	assert.Assert(t, entity.RelationsOfType(RelationHasMember) == nil)
+}
@@ -0,0 +1,37 @@
+package catalog
+
+// EntityStatus is the status of an entity, as reported by the catalog.
+//
+// See: https://backstage.io/docs/features/software-catalog/descriptor-format#common-to-all-kinds-status
+type EntityStatus struct {
+	// Items of the status.
+	Items []EntityStatusItem `json:"items,omitempty"`
+}
+
+// EntityStatusItem is a status item of an entity, e.g. an error from processing the entity.
+type EntityStatusItem struct {
+	// Type of the status item, e.g. "backstage.io/catalog-processing".
+	Type string `json:"type"`
+
+	// Level of the status item.
+	Level EntityStatusLevel `json:"level"`
+
+	// Message is a human-readable description of the status item.
+	Message string `json:"message"`
+
+	// Error is the error that caused the status item, if any.
+	Error *SerializedError `json:"error,omitempty"`
+}
+
+// EntityStatusLevel represents the severity level of an entity status item.
+type EntityStatusLevel string
+
+// Known EntityStatusLevel values.
+const (
+	// EntityStatusLevelInfo is an informational status item.
+	EntityStatusLevelInfo EntityStatusLevel = "info"
+	// EntityStatusLevelWarning is a status item for something that may need attention.
+	EntityStatusLevelWarning EntityStatusLevel = "warning"
+	// EntityStatusLevelError is a status item for an error.
+	EntityStatusLevelError EntityStatusLevel = "error"
+)
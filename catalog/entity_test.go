@@ -0,0 +1,127 @@
+package catalog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestEntity_UnmarshalJSON(t *testing.T) {
+	//nolint: lll
+	const component = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo"},"spec":{"type":"service","owner":"team-a"},"status":{"items":[{"type":"backstage.io/catalog-processing","level":"error","message":"Processing failed","error":{"name":"InputError","message":"Invalid spec"}}]}}`
+	var entity Entity
+	assert.NilError(t, json.Unmarshal([]byte(component), &entity))
+	assert.Equal(t, `{"type":"service","owner":"team-a"}`, string(entity.Spec))
+	assert.DeepEqual(t, &EntityStatus{
+		Items: []EntityStatusItem{
+			{
+				Type:    "backstage.io/catalog-processing",
+				Level:   EntityStatusLevelError,
+				Message: "Processing failed",
+				Error:   &SerializedError{Name: "InputError", Message: "Invalid spec"},
+			},
+		},
+	}, entity.Status)
+}
+
+func TestEntity_MarshalJSON(t *testing.T) {
+	//nolint: lll
+	const component = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo","annotations":{"example.com/a":"a"},"custom":{"x":1}},"spec":{"type":"service","custom":true},"relations":[{"type":"ownedBy","targetRef":"group:default/team-a","target":{"kind":"group","namespace":"default","name":"team-a"}}],"custom":"value"}`
+
+	t.Run("unmodified", func(t *testing.T) {
+		var entity Entity
+		assert.NilError(t, json.Unmarshal([]byte(component), &entity))
+		data, err := json.Marshal(&entity)
+		assert.NilError(t, err)
+		assert.Equal(t, component, string(data))
+	})
+
+	t.Run("unmodified unsorted annotations and labels", func(t *testing.T) {
+		//nolint: lll
+		const unsorted = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo","labels":{"z":"1","a":"2"},"annotations":{"example.com/z":"z","example.com/a":"a"}},"spec":{"type":"service"}}`
+		var entity Entity
+		assert.NilError(t, json.Unmarshal([]byte(unsorted), &entity))
+		entity.Metadata.Title = "Foo"
+		data, err := json.Marshal(&entity)
+		assert.NilError(t, err)
+		//nolint: lll
+		const expected = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo","labels":{"z":"1","a":"2"},"annotations":{"example.com/z":"z","example.com/a":"a"},"title":"Foo"},"spec":{"type":"service"}}`
+		assert.Equal(t, expected, string(data))
+	})
+
+	t.Run("modified metadata", func(t *testing.T) {
+		var entity Entity
+		assert.NilError(t, json.Unmarshal([]byte(component), &entity))
+		entity.Metadata.Annotations["example.com/b"] = "b"
+		entity.Metadata.Title = "Foo"
+		data, err := json.Marshal(&entity)
+		assert.NilError(t, err)
+		//nolint: lll
+		const expected = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo","annotations":{"example.com/a":"a","example.com/b":"b"},"custom":{"x":1},"title":"Foo"},"spec":{"type":"service","custom":true},"relations":[{"type":"ownedBy","targetRef":"group:default/team-a","target":{"kind":"group","namespace":"default","name":"team-a"}}],"custom":"value"}`
+		assert.Equal(t, expected, string(data))
+	})
+
+	t.Run("removed fields", func(t *testing.T) {
+		var entity Entity
+		assert.NilError(t, json.Unmarshal([]byte(component), &entity))
+		entity.Metadata.Annotations = nil
+		entity.Spec = nil
+		entity.Relations = nil
+		data, err := json.Marshal(&entity)
+		assert.NilError(t, err)
+		//nolint: lll
+		const expected = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo","custom":{"x":1}},"custom":"value"}`
+		assert.Equal(t, expected, string(data))
+	})
+
+	t.Run("modified relations and status", func(t *testing.T) {
+		var entity Entity
+		assert.NilError(t, json.Unmarshal([]byte(component), &entity))
+		entity.Relations = append(entity.Relations, EntityRelation{Type: "partOf", TargetRef: "system:default/bar"})
+		entity.Status = &EntityStatus{Items: []EntityStatusItem{{Type: "foo", Level: EntityStatusLevelInfo}}}
+		data, err := json.Marshal(&entity)
+		assert.NilError(t, err)
+		//nolint: lll
+		const expected = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo","annotations":{"example.com/a":"a"},"custom":{"x":1}},"spec":{"type":"service","custom":true},"relations":[{"type":"ownedBy","targetRef":"group:default/team-a"},{"type":"partOf","targetRef":"system:default/bar"}],"custom":"value","status":{"items":[{"type":"foo","level":"info","message":""}]}}`
+		assert.Equal(t, expected, string(data))
+	})
+
+	t.Run("links", func(t *testing.T) {
+		//nolint: lll
+		const withLinks = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo","links":[{"url":"https://x","custom":"keep"}]}}`
+		var entity Entity
+		assert.NilError(t, json.Unmarshal([]byte(withLinks), &entity))
+		entity.Metadata.Annotations = map[string]string{"example.com/a": "a"}
+		data, err := json.Marshal(&entity)
+		assert.NilError(t, err)
+		//nolint: lll
+		const expected = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo","links":[{"url":"https://x","custom":"keep"}],"annotations":{"example.com/a":"a"}}}`
+		assert.Equal(t, expected, string(data))
+		entity.Metadata.Links = append(entity.Metadata.Links, EntityLink{URL: "https://y"})
+		data, err = json.Marshal(&entity)
+		assert.NilError(t, err)
+		//nolint: lll
+		const modified = `{"apiVersion":"backstage.io/v1alpha1","kind":"Component","metadata":{"name":"foo","links":[{"url":"https://x"},{"url":"https://y"}],"annotations":{"example.com/a":"a"}}}`
+		assert.Equal(t, modified, string(data))
+	})
+
+	t.Run("without raw JSON", func(t *testing.T) {
+		entity := &Entity{
+			APIVersion: "backstage.io/v1alpha1",
+			Kind:       EntityKindGroup,
+			Metadata:   EntityMetadata{Name: "team-a"},
+			Spec:       json.RawMessage(`{"type":"team"}`),
+		}
+		data, err := json.Marshal(entity)
+		assert.NilError(t, err)
+		//nolint: lll
+		const expected = `{"apiVersion":"backstage.io/v1alpha1","kind":"Group","metadata":{"name":"team-a"},"spec":{"type":"team"}}`
+		assert.Equal(t, expected, string(data))
+		var roundTripped Entity
+		assert.NilError(t, json.Unmarshal(data, &roundTripped))
+		spec, err := roundTripped.GroupSpec()
+		assert.NilError(t, err)
+		assert.Equal(t, "team", spec.Type)
+	})
+}
@@ -0,0 +1,165 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TypedEntity is an [Entity] with its spec decoded as S.
+//
+// S can be any of the standard spec types, such as [ComponentSpec], or a user-defined spec type for custom kinds
+// and custom spec fields.
+type TypedEntity[S any] struct {
+	// APIVersion is the version of specification format for this particular entity.
+	APIVersion string
+
+	// Kind is the high-level entity type.
+	Kind EntityKind
+
+	// Metadata related to the entity.
+	Metadata EntityMetadata
+
+	// Spec of the entity.
+	Spec S
+
+	// Relations that this entity has with other entities.
+	Relations []EntityRelation
+
+	// Status of the entity, as reported by the catalog, if any.
+	Status *EntityStatus
+
+	// Raw entity JSON message.
+	Raw json.RawMessage
+}
+
+// DecodeSpec decodes the entity's spec as T, regardless of the entity's kind.
+//
+// Returns nil if the entity has no spec.
+func DecodeSpec[T any](e *Entity) (*T, error) {
+	if len(e.Spec) == 0 {
+		return nil, nil
+	}
+	var spec *T
+	if err := json.Unmarshal(e.Spec, &spec); err != nil {
+		return nil, fmt.Errorf("decode spec of %s: %w", e.Ref(), err)
+	}
+	return spec, nil
+}
+
+// DecodeEntity decodes an entity as a [TypedEntity] with its spec decoded as S.
+//
+// If S is one of the standard spec types, such as [ComponentSpec], the entity must be of the corresponding kind.
+// If the entity has no spec, the spec is the zero value of S.
+func DecodeEntity[S any](e *Entity) (*TypedEntity[S], error) {
+	if kind, ok := standardSpecKind[S](); ok && !strings.EqualFold(string(e.Kind), string(kind)) {
+		return nil, fmt.Errorf("decode entity %s: expected kind %s but was %s", e.Ref(), kind, e.Kind)
+	}
+	spec, err := DecodeSpec[S](e)
+	if err != nil {
+		return nil, err
+	}
+	result := &TypedEntity[S]{
+		APIVersion: e.APIVersion,
+		Kind:       e.Kind,
+		Metadata:   e.Metadata,
+		Relations:  e.Relations,
+		Status:     e.Status,
+		Raw:        e.Raw,
+	}
+	if spec != nil {
+		result.Spec = *spec
+	}
+	return result, nil
+}
+
+// DecodeEntities decodes entities as [TypedEntity] values with their specs decoded as S.
+func DecodeEntities[S any](entities []*Entity) ([]*TypedEntity[S], error) {
+	result := make([]*TypedEntity[S], 0, len(entities))
+	for _, entity := range entities {
+		typedEntity, err := DecodeEntity[S](entity)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, typedEntity)
+	}
+	return result, nil
+}
+
+// ListTypedEntities lists all entities of a kind in the catalog, with their specs decoded as S.
+//
+// The kind is added as a condition to every filter of the request, and filters must not have kind conditions of their
+// own. For example, to list all production components:
+//
+//	components, err := catalog.ListTypedEntities[catalog.ComponentSpec](ctx, client, catalog.EntityKindComponent,
+//		&catalog.ListEntitiesAllRequest{Filters: []string{"spec.lifecycle=production"}})
+func ListTypedEntities[S any](
+	ctx context.Context,
+	client *Client,
+	kind EntityKind,
+	request *ListEntitiesAllRequest,
+) ([]*TypedEntity[S], error) {
+	kindFilter := "kind=" + string(kind)
+	kindRequest := *request
+	kindRequest.Filters = []string{kindFilter}
+	if len(request.Filters) > 0 {
+		kindRequest.Filters = make([]string, 0, len(request.Filters))
+		for _, filter := range request.Filters {
+			// A repeated key matches any of its values, so a kind condition in the filter would widen the result.
+			if hasKindCondition(filter) {
+				return nil, fmt.Errorf("list %s entities: filter %q has a kind condition", kind, filter)
+			}
+			kindRequest.Filters = append(kindRequest.Filters, filter+","+kindFilter)
+		}
+	}
+	var result []*TypedEntity[S]
+	for entity, err := range client.ListEntitiesAll(ctx, &kindRequest) {
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(string(entity.Kind), string(kind)) {
+			return nil, fmt.Errorf("list %s entities: unexpected kind %s of %s", kind, entity.Kind, entity.Ref())
+		}
+		typedEntity, err := DecodeEntity[S](entity)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, typedEntity)
+	}
+	return result, nil
+}
+
+func hasKindCondition(filter string) bool {
+	for _, statement := range strings.Split(filter, ",") {
+		key, _, _ := strings.Cut(statement, "=")
+		if strings.EqualFold(strings.TrimSpace(key), "kind") {
+			return true
+		}
+	}
+	return false
+}
+
+func standardSpecKind[S any]() (EntityKind, bool) {
+	switch any((*S)(nil)).(type) {
+	case *APISpec:
+		return EntityKindAPI, true
+	case *ComponentSpec:
+		return EntityKindComponent, true
+	case *DomainSpec:
+		return EntityKindDomain, true
+	case *GroupSpec:
+		return EntityKindGroup, true
+	case *LocationSpec:
+		return EntityKindLocation, true
+	case *ResourceSpec:
+		return EntityKindResource, true
+	case *SystemSpec:
+		return EntityKindSystem, true
+	case *TemplateSpec:
+		return EntityKindTemplate, true
+	case *UserSpec:
+		return EntityKindUser, true
+	}
+	return "", false
+}
@@ -0,0 +1,142 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestDecodeSpec(t *testing.T) {
+	type customSpec struct {
+		Owner string `json:"owner"`
+		Tier  int    `json:"tier"`
+	}
+	t.Run("custom spec", func(t *testing.T) {
+		var entity Entity
+		assert.NilError(t, json.Unmarshal([]byte(`{
+  "apiVersion": "example.com/v1",
+  "kind": "Database",
+  "metadata": {"name": "payments-db"},
+  "spec": {"owner": "team-payments", "tier": 1}
+}`), &entity))
+		spec, err := DecodeSpec[customSpec](&entity)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, &customSpec{Owner: "team-payments", Tier: 1}, spec)
+	})
+
+	t.Run("no spec", func(t *testing.T) {
+		spec, err := DecodeSpec[customSpec](&Entity{Kind: "Database"})
+		assert.NilError(t, err)
+		assert.Assert(t, spec == nil)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		entity := &Entity{
+			Kind:     "Database",
+			Metadata: EntityMetadata{Name: "payments-db"},
+			Spec:     json.RawMessage(`{"tier": "one"}`),
+		}
+		_, err := DecodeSpec[customSpec](entity)
+		assert.ErrorContains(t, err, "decode spec of database:default/payments-db")
+	})
+}
+
+func TestDecodeEntity(t *testing.T) {
+	var entity Entity
+	assert.NilError(t, json.Unmarshal([]byte(`{
+  "apiVersion": "backstage.io/v1alpha1",
+  "kind": "Component",
+  "metadata": {"name": "payments", "annotations": {"example.com/custom": "true"}},
+  "spec": {"type": "service", "lifecycle": "production", "owner": "team-payments"},
+  "relations": [{"type": "ownedBy", "targetRef": "group:default/team-payments"}]
+}`), &entity))
+	typedEntity, err := DecodeEntity[ComponentSpec](&entity)
+	assert.NilError(t, err)
+	assert.Equal(t, "backstage.io/v1alpha1", typedEntity.APIVersion)
+	assert.Equal(t, EntityKindComponent, typedEntity.Kind)
+	assert.Equal(t, "payments", typedEntity.Metadata.Name)
+	assert.Equal(t, "true", typedEntity.Metadata.Annotations["example.com/custom"])
+	assert.Equal(t, "service", typedEntity.Spec.Type)
+	assert.Equal(t, "production", typedEntity.Spec.Lifecycle)
+	assert.Equal(t, "team-payments", typedEntity.Spec.Owner)
+	assert.DeepEqual(t, entity.Relations, typedEntity.Relations)
+	assert.DeepEqual(t, entity.Raw, typedEntity.Raw)
+	t.Run("wrong kind", func(t *testing.T) {
+		_, err := DecodeEntity[SystemSpec](&entity)
+		assert.ErrorContains(t, err, "expected kind System but was Component")
+	})
+	t.Run("custom spec", func(t *testing.T) {
+		type customSpec struct {
+			Type string `json:"type"`
+		}
+		typedEntity, err := DecodeEntity[customSpec](&entity)
+		assert.NilError(t, err)
+		assert.Equal(t, "service", typedEntity.Spec.Type)
+	})
+}
+
+func TestListTypedEntities(t *testing.T) {
+	ctx := context.Background()
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/catalog/entities", r.URL.Path)
+			assert.DeepEqual(t, []string{
+				"spec.lifecycle=production,kind=Component",
+				"spec.lifecycle=experimental,kind=Component",
+			}, r.URL.Query()["filter"])
+			_, _ = w.Write([]byte(`[
+  {"kind": "Component", "metadata": {"name": "payments"}, "spec": {"type": "service", "lifecycle": "production"}},
+  {"kind": "Component", "metadata": {"name": "ledger"}, "spec": {"type": "library", "lifecycle": "experimental"}}
+]`))
+		})
+		components, err := ListTypedEntities[ComponentSpec](ctx, client, EntityKindComponent, &ListEntitiesAllRequest{
+			Filters: []string{"spec.lifecycle=production", "spec.lifecycle=experimental"},
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, 2, len(components))
+		assert.Equal(t, "payments", components[0].Metadata.Name)
+		assert.Equal(t, "service", components[0].Spec.Type)
+		assert.Equal(t, "ledger", components[1].Metadata.Name)
+		assert.Equal(t, "library", components[1].Spec.Type)
+	})
+
+	t.Run("kind only", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.DeepEqual(t, []string{"kind=System"}, r.URL.Query()["filter"])
+			_, _ = w.Write([]byte(`[]`))
+		})
+		systems, err := ListTypedEntities[SystemSpec](ctx, client, EntityKindSystem, &ListEntitiesAllRequest{})
+		assert.NilError(t, err)
+		assert.Equal(t, 0, len(systems))
+	})
+
+	t.Run("kind condition", func(t *testing.T) {
+		client := newTestClient(t, func(http.ResponseWriter, *http.Request) {
+			t.Error("unexpected request")
+		})
+		_, err := ListTypedEntities[ComponentSpec](ctx, client, EntityKindComponent, &ListEntitiesAllRequest{
+			Filters: []string{"spec.lifecycle=production", "spec.type=service, Kind=API"},
+		})
+		assert.ErrorContains(t, err, `filter "spec.type=service, Kind=API" has a kind condition`)
+	})
+
+	t.Run("unexpected kind", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[{"kind": "API", "metadata": {"name": "payments-api"}, "spec": {"type": "openapi"}}]`))
+		})
+		_, err := ListTypedEntities[ComponentSpec](ctx, client, EntityKindComponent, &ListEntitiesAllRequest{})
+		assert.ErrorContains(t, err, "unexpected kind API")
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		})
+		_, err := ListTypedEntities[ComponentSpec](ctx, client, EntityKindComponent, &ListEntitiesAllRequest{})
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
@@ -1,25 +1,25 @@
 package catalog
 
-import (
-	"net/http"
+import "go.einride.tech/backstage/internal/backstagehttp"
+
+// Sentinel errors for common HTTP status errors, for use with [errors.Is].
+var (
+	// ErrBadRequest is matched by a [StatusError] with status code 400.
+	ErrBadRequest = backstagehttp.ErrBadRequest
+	// ErrUnauthorized is matched by a [StatusError] with status code 401.
+	ErrUnauthorized = backstagehttp.ErrUnauthorized
+	// ErrForbidden is matched by a [StatusError] with status code 403.
+	ErrForbidden = backstagehttp.ErrForbidden
+	// ErrNotFound is matched by a [StatusError] with status code 404.
+	ErrNotFound = backstagehttp.ErrNotFound
+	// ErrConflict is matched by a [StatusError] with status code 409.
+	ErrConflict = backstagehttp.ErrConflict
 )
 
 // StatusError represents an HTTP status error.
-type StatusError struct {
-	// Status of the error.
-	Status string
-	// StatusCode of the error.
-	StatusCode int
-}
-
-func newStatusError(httpResponse *http.Response) error {
-	return &StatusError{
-		Status:     httpResponse.Status,
-		StatusCode: httpResponse.StatusCode,
-	}
-}
+//
+// The error's Name, Message and Cause are reported by the server, as are the method and URL of the failed request.
+type StatusError = backstagehttp.StatusError
 
-// Error implements error.
-func (s *StatusError) Error() string {
-	return s.Status
-}
+// SerializedError is an error serialized by the Backstage backend.
+type SerializedError = backstagehttp.SerializedError
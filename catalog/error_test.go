@@ -0,0 +1,72 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestStatusError(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("backstage error body", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{` +
+				`"error":{"name":"NotFoundError","message":"No entity with uid test",` +
+				`"cause":{"name":"Error","message":"missing row"}},` +
+				`"request":{"method":"GET","url":"/entities/by-uid/test"},` +
+				`"response":{"statusCode":404}}`))
+		})
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.DeepEqual(t, &StatusError{
+			Status:        "404 Not Found",
+			StatusCode:    http.StatusNotFound,
+			Name:          "NotFoundError",
+			Message:       "No entity with uid test",
+			Cause:         &SerializedError{Name: "Error", Message: "missing row"},
+			RequestMethod: "GET",
+			RequestURL:    "/entities/by-uid/test",
+		}, errStatus)
+		assert.Error(t, err, "GET /api/catalog/entities/by-uid/test: 404 Not Found: No entity with uid test")
+		assert.Assert(t, errors.Is(err, ErrNotFound))
+		assert.Assert(t, !errors.Is(err, ErrConflict))
+	})
+
+	t.Run("non-JSON body", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html>Bad Gateway</html>`))
+		})
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.DeepEqual(t, &StatusError{Status: "502 Bad Gateway", StatusCode: http.StatusBadGateway}, errStatus)
+		assert.Error(t, err, "GET /api/catalog/entities/by-uid/test: 502 Bad Gateway")
+	})
+
+	t.Run("sentinel errors", func(t *testing.T) {
+		for _, tt := range []struct {
+			statusCode int
+			sentinel   error
+		}{
+			{statusCode: http.StatusBadRequest, sentinel: ErrBadRequest},
+			{statusCode: http.StatusUnauthorized, sentinel: ErrUnauthorized},
+			{statusCode: http.StatusForbidden, sentinel: ErrForbidden},
+			{statusCode: http.StatusNotFound, sentinel: ErrNotFound},
+			{statusCode: http.StatusConflict, sentinel: ErrConflict},
+		} {
+			t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+				err := error(&StatusError{StatusCode: tt.statusCode})
+				assert.Assert(t, errors.Is(err, tt.sentinel))
+				assert.Assert(t, !errors.Is(&StatusError{StatusCode: http.StatusInternalServerError}, tt.sentinel))
+			})
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FilterBuilder builds entity filters for [ListEntitiesRequest] and [QueryEntitiesRequest].
+//
+// Conditions added to a builder must all match (AND), and alternatives added with [FilterBuilder.Or] are
+// matched if any of them match (OR). Conditions added after an alternative apply to all alternatives.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#filtering
+type FilterBuilder struct {
+	alternatives [][]filterCondition
+	err          error
+}
+
+type filterCondition struct {
+	key   string
+	value string
+	// exists is true for conditions that only check for the existence of the key.
+	exists bool
+}
+
+// Filter returns a new [FilterBuilder].
+func Filter() *FilterBuilder {
+	return &FilterBuilder{alternatives: [][]filterCondition{nil}}
+}
+
+// Kind adds a condition that the entity kind is kind.
+func (f *FilterBuilder) Kind(kind EntityKind) *FilterBuilder {
+	return f.Eq("kind", string(kind))
+}
+
+// Eq adds a condition that the value at the dot-separated key path is value.
+//
+// Values are matched case-insensitively, and a key path into an array matches if any item in the array matches.
+func (f *FilterBuilder) Eq(key, value string) *FilterBuilder {
+	return f.In(key, value)
+}
+
+// In adds a condition that the value at the dot-separated key path is any of values.
+func (f *FilterBuilder) In(key string, values ...string) *FilterBuilder {
+	if !f.validateKey(key) {
+		return f
+	}
+	if len(values) == 0 {
+		f.setError(fmt.Errorf("filter %s: no values", key))
+		return f
+	}
+	for _, value := range values {
+		if strings.Contains(value, ",") {
+			f.setError(fmt.Errorf("filter %s: value %q contains a comma, which is unsupported by the catalog", key, value))
+			return f
+		}
+		f.add(filterCondition{key: key, value: value})
+	}
+	return f
+}
+
+// Exists adds a condition that the dot-separated key path exists, with any value.
+func (f *FilterBuilder) Exists(key string) *FilterBuilder {
+	if f.validateKey(key) {
+		f.add(filterCondition{key: key, exists: true})
+	}
+	return f
+}
+
+// Or adds the alternatives of other to the filter, so that entities matching either filter are selected.
+func (f *FilterBuilder) Or(other *FilterBuilder) *FilterBuilder {
+	if other.err != nil {
+		f.setError(other.err)
+	}
+	for _, conditions := range other.alternatives {
+		f.alternatives = append(f.alternatives, append([]filterCondition(nil), conditions...))
+	}
+	return f
+}
+
+// Build returns the filter as values for the Filters field of [ListEntitiesRequest] and [QueryEntitiesRequest].
+//
+// An alternative without conditions matches all entities, and so does the filter: Build then returns no filters.
+func (f *FilterBuilder) Build() ([]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	result := make([]string, 0, len(f.alternatives))
+	for _, conditions := range f.alternatives {
+		if len(conditions) == 0 {
+			return nil, nil
+		}
+		var filter strings.Builder
+		for i, condition := range conditions {
+			if i > 0 {
+				_ = filter.WriteByte(',')
+			}
+			_, _ = filter.WriteString(condition.key)
+			if !condition.exists {
+				_ = filter.WriteByte('=')
+				_, _ = filter.WriteString(condition.value)
+			}
+		}
+		result = append(result, filter.String())
+	}
+	return result, nil
+}
+
+func (f *FilterBuilder) add(condition filterCondition) {
+	for i := range f.alternatives {
+		f.alternatives[i] = append(f.alternatives[i], condition)
+	}
+}
+
+func (f *FilterBuilder) validateKey(key string) bool {
+	switch {
+	case key == "":
+		f.setError(fmt.Errorf("filter: empty key"))
+		return false
+	case strings.ContainsAny(key, ",="):
+		f.setError(fmt.Errorf("filter %q: key must not contain ',' or '='", key))
+		return false
+	}
+	return true
+}
+
+func (f *FilterBuilder) setError(err error) {
+	if f.err == nil {
+		f.err = err
+	}
+}
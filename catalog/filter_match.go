@@ -0,0 +1,175 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EntityFilter is a compiled set of entity filters, for matching entities in memory.
+//
+// Matching follows the semantics of the catalog API: filters are alternatives (OR), the comma-separated conditions of
+// a filter must all match (AND), and repeated keys within a filter match any of their values.
+type EntityFilter struct {
+	alternatives []map[string]*entityFilterCondition
+}
+
+type entityFilterCondition struct {
+	values []string
+	exists bool
+}
+
+// CompileFilter compiles filters on the same format as the Filters field of [ListEntitiesRequest].
+func CompileFilter(filters []string) (*EntityFilter, error) {
+	result := &EntityFilter{}
+	for _, filter := range filters {
+		conditions := map[string]*entityFilterCondition{}
+		for _, statement := range strings.Split(filter, ",") {
+			// Like the catalog, skip empty statements.
+			if strings.TrimSpace(statement) == "" {
+				continue
+			}
+			key, value, hasValue := strings.Cut(statement, "=")
+			key = strings.ToLower(strings.TrimSpace(key))
+			if key == "" {
+				return nil, fmt.Errorf("compile filter %q: empty key", filter)
+			}
+			condition, ok := conditions[key]
+			if !ok {
+				condition = &entityFilterCondition{}
+				conditions[key] = condition
+			}
+			if hasValue {
+				condition.values = append(condition.values, strings.ToLower(strings.TrimSpace(value)))
+			} else {
+				condition.exists = true
+			}
+		}
+		// Like the catalog, skip filters without statements.
+		if len(conditions) == 0 {
+			continue
+		}
+		result.alternatives = append(result.alternatives, conditions)
+	}
+	return result, nil
+}
+
+// Match returns true if the entity matches the filter. An empty filter matches all entities.
+func (f *EntityFilter) Match(entity *Entity) bool {
+	if len(f.alternatives) == 0 {
+		return true
+	}
+	search := newEntitySearch(entity)
+	for _, conditions := range f.alternatives {
+		if search.matchAll(conditions) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchFilter returns true if the entity matches the filters, with the same semantics as the catalog API.
+//
+// Filters are on the same format as the Filters field of [ListEntitiesRequest]. When matching many entities against
+// the same filters, use [CompileFilter].
+func MatchFilter(entity *Entity, filters []string) (bool, error) {
+	filter, err := CompileFilter(filters)
+	if err != nil {
+		return false, err
+	}
+	return filter.Match(entity), nil
+}
+
+// maxEntitySearchValueLength is the maximum length of a value indexed by the catalog for filtering.
+const maxEntitySearchValueLength = 200
+
+// entitySearch is the flattened, lower-cased key-value representation of an entity that the catalog filters on.
+type entitySearch map[string][]string
+
+func newEntitySearch(entity *Entity) entitySearch {
+	search := entitySearch{}
+	raw := entity.Raw
+	if len(raw) == 0 {
+		raw, _ = json.Marshal(entity)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var document map[string]any
+	if err := decoder.Decode(&document); err == nil {
+		for key, value := range document {
+			switch key {
+			case "attachments", "relations", "status":
+				continue
+			}
+			search.traverse(key, value)
+		}
+	}
+	if _, ok := search["metadata.namespace"]; !ok {
+		search.add("metadata.namespace", DefaultNamespace)
+	}
+	for _, relation := range entity.Relations {
+		search.add("relations."+string(relation.Type), relation.TargetRef)
+	}
+	return search
+}
+
+func (s entitySearch) traverse(path string, value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, child := range value {
+			s.traverse(path+"."+key, child)
+		}
+	case []any:
+		for _, item := range value {
+			s.traverse(path, item)
+			// Array string items are also indexed as keys, e.g. metadata.tags.go=true.
+			if item, ok := item.(string); ok {
+				s.add(path+"."+item, "true")
+			}
+		}
+	case string:
+		s.add(path, value)
+	case json.Number:
+		s.add(path, value.String())
+	case bool:
+		s.add(path, fmt.Sprint(value))
+	case nil:
+		s.addKey(path)
+	}
+}
+
+func (s entitySearch) add(key, value string) {
+	key = strings.ToLower(key)
+	if len(value) > maxEntitySearchValueLength {
+		s.addKey(key)
+		return
+	}
+	s[key] = append(s[key], strings.ToLower(value))
+}
+
+func (s entitySearch) addKey(key string) {
+	key = strings.ToLower(key)
+	if _, ok := s[key]; !ok {
+		s[key] = nil
+	}
+}
+
+func (s entitySearch) matchAll(conditions map[string]*entityFilterCondition) bool {
+	for key, condition := range conditions {
+		values, ok := s[key]
+		if !ok {
+			return false
+		}
+		if condition.exists {
+			continue
+		}
+		if !slices.ContainsFunc(values, func(value string) bool {
+			return slices.Contains(condition.values, value)
+		}) {
+			return false
+		}
+	}
+	return true
+}
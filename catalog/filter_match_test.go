@@ -0,0 +1,154 @@
+package catalog
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMatchFilter(t *testing.T) {
+	const component = `{
+  "apiVersion": "backstage.io/v1alpha1",
+  "kind": "Component",
+  "metadata": {
+    "name": "Podcast-API",
+    "annotations": {"github.com/project-slug": "example/podcast-api", "example.com/empty": null},
+    "labels": {"tier": "1"},
+    "tags": ["go", "Backend"]
+  },
+  "spec": {
+    "type": "service",
+    "lifecycle": "production",
+    "owner": "team-a",
+    "replicas": 3,
+    "public": false,
+    "ports": [{"name": "http", "port": 8080}, {"name": "grpc", "port": 9090}]
+  },
+  "relations": [
+    {"type": "ownedBy", "targetRef": "group:default/team-a"},
+    {"type": "dependsOn", "targetRef": "resource:default/podcast-db"}
+  ],
+  "status": {"items": [{"type": "foo", "level": "error"}]}
+}`
+	var entity Entity
+	assert.NilError(t, json.Unmarshal([]byte(component), &entity))
+	for _, tt := range []struct {
+		name     string
+		filters  []string
+		expected bool
+	}{
+		// Basics.
+		{name: "no filters", filters: nil, expected: true},
+		{name: "kind", filters: []string{"kind=Component"}, expected: true},
+		{name: "kind mismatch", filters: []string{"kind=API"}, expected: false},
+		{name: "missing key", filters: []string{"spec.system=foo"}, expected: false},
+		// Case-insensitivity.
+		{name: "value case", filters: []string{"kind=component"}, expected: true},
+		{name: "key case", filters: []string{"KIND=Component"}, expected: true},
+		{name: "name case", filters: []string{"metadata.name=podcast-api"}, expected: true},
+		{name: "nested key case", filters: []string{"metadata.annotations.GitHub.com/project-slug"}, expected: true},
+		// Whitespace.
+		{name: "trimmed", filters: []string{" kind = Component , spec.type=service"}, expected: true},
+		// AND, OR and any-of.
+		{name: "and", filters: []string{"kind=Component,spec.lifecycle=production"}, expected: true},
+		{name: "and mismatch", filters: []string{"kind=Component,spec.lifecycle=experimental"}, expected: false},
+		{name: "or", filters: []string{"kind=API", "spec.type=service"}, expected: true},
+		{name: "or mismatch", filters: []string{"kind=API", "spec.type=website"}, expected: false},
+		{name: "any of", filters: []string{"kind=API,kind=Component"}, expected: true},
+		{name: "any of mismatch", filters: []string{"kind=API,kind=System"}, expected: false},
+		// Existence.
+		{name: "exists", filters: []string{"metadata.annotations.github.com/project-slug"}, expected: true},
+		{name: "exists null", filters: []string{"metadata.annotations.example.com/empty"}, expected: true},
+		{name: "exists object", filters: []string{"spec.ports"}, expected: false},
+		{name: "not exists", filters: []string{"metadata.annotations.backstage.io/techdocs-ref"}, expected: false},
+		{name: "exists and value", filters: []string{"spec.owner,spec.owner=nobody"}, expected: true},
+		// Defaults.
+		{name: "default namespace", filters: []string{"metadata.namespace=default"}, expected: true},
+		// Arrays.
+		{name: "array item", filters: []string{"metadata.tags=go"}, expected: true},
+		{name: "array item case", filters: []string{"metadata.tags=backend"}, expected: true},
+		{name: "array item mismatch", filters: []string{"metadata.tags=java"}, expected: false},
+		{name: "array item key", filters: []string{"metadata.tags.go"}, expected: true},
+		{name: "array item key value", filters: []string{"metadata.tags.backend=true"}, expected: true},
+		{name: "array of objects", filters: []string{"spec.ports.name=grpc"}, expected: true},
+		// Scalars.
+		{name: "number", filters: []string{"spec.replicas=3"}, expected: true},
+		{name: "boolean", filters: []string{"spec.public=false"}, expected: true},
+		{name: "label", filters: []string{"metadata.labels.tier=1"}, expected: true},
+		// Relations.
+		{name: "relation", filters: []string{"relations.ownedBy=group:default/team-a"}, expected: true},
+		{name: "relation case", filters: []string{"relations.ownedby=Group:Default/Team-A"}, expected: true},
+		{name: "relation exists", filters: []string{"relations.dependsOn"}, expected: true},
+		{name: "relation mismatch", filters: []string{"relations.ownedBy=group:default/team-b"}, expected: false},
+		{name: "relations not traversed", filters: []string{"relations.type=ownedBy"}, expected: false},
+		// Skipped keys.
+		{name: "status not indexed", filters: []string{"status.items.level=error"}, expected: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := MatchFilter(&entity, tt.filters)
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	t.Run("long values not matched", func(t *testing.T) {
+		var entity Entity
+		longValue := strings.Repeat("a", maxEntitySearchValueLength+1)
+		assert.NilError(t, json.Unmarshal(
+			[]byte(`{"kind":"Component","metadata":{"name":"foo","description":"`+longValue+`"}}`),
+			&entity,
+		))
+		actual, err := MatchFilter(&entity, []string{"metadata.description=" + longValue})
+		assert.NilError(t, err)
+		assert.Assert(t, !actual)
+		actual, err = MatchFilter(&entity, []string{"metadata.description"})
+		assert.NilError(t, err)
+		assert.Assert(t, actual)
+	})
+
+	t.Run("entity without raw JSON", func(t *testing.T) {
+		entity := &Entity{
+			Kind:      EntityKindGroup,
+			Metadata:  EntityMetadata{Name: "team-a", Namespace: "org"},
+			Relations: []EntityRelation{{Type: "hasMember", TargetRef: "user:default/jane"}},
+		}
+		actual, err := MatchFilter(entity, []string{
+			"kind=group,metadata.namespace=org,relations.hasMember=user:default/jane",
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, actual)
+	})
+
+	t.Run("empty statements", func(t *testing.T) {
+		// The catalog skips empty statements, and filters without statements.
+		actual, err := MatchFilter(&entity, []string{"kind=Component,"})
+		assert.NilError(t, err)
+		assert.Assert(t, actual)
+		actual, err = MatchFilter(&entity, []string{",kind=API, ,"})
+		assert.NilError(t, err)
+		assert.Assert(t, !actual)
+		actual, err = MatchFilter(&entity, []string{",", "kind=API"})
+		assert.NilError(t, err)
+		assert.Assert(t, !actual)
+		actual, err = MatchFilter(&entity, []string{" , "})
+		assert.NilError(t, err)
+		assert.Assert(t, actual)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		_, err := MatchFilter(&entity, []string{"=foo"})
+		assert.ErrorContains(t, err, "empty key")
+		_, err = MatchFilter(&entity, []string{"kind=Component, =foo"})
+		assert.ErrorContains(t, err, "empty key")
+	})
+
+	t.Run("filter builder", func(t *testing.T) {
+		filters, err := Filter().Kind("API").Or(Filter().Kind("Component").Exists("spec.owner")).Build()
+		assert.NilError(t, err)
+		actual, err := MatchFilter(&entity, filters)
+		assert.NilError(t, err)
+		assert.Assert(t, actual)
+	})
+}
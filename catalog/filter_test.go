@@ -0,0 +1,105 @@
+package catalog
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestFilter(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		filter        *FilterBuilder
+		expected      []string
+		errorContains string
+	}{
+		{
+			name:     "empty",
+			filter:   Filter(),
+			expected: nil,
+		},
+		{
+			name:     "kind",
+			filter:   Filter().Kind(EntityKindComponent),
+			expected: []string{"kind=Component"},
+		},
+		{
+			name: "and",
+			filter: Filter().
+				Kind("Component").
+				Eq("spec.lifecycle", "production").
+				Exists("metadata.annotations.github.com/project-slug"),
+			expected: []string{"kind=Component,spec.lifecycle=production,metadata.annotations.github.com/project-slug"},
+		},
+		{
+			name:     "in",
+			filter:   Filter().In("spec.type", "service", "website"),
+			expected: []string{"spec.type=service,spec.type=website"},
+		},
+		{
+			name:     "or",
+			filter:   Filter().Kind("Component").Or(Filter().Kind("API").Eq("spec.type", "openapi")),
+			expected: []string{"kind=Component", "kind=API,spec.type=openapi"},
+		},
+		{
+			name:     "and after or",
+			filter:   Filter().Kind("Component").Or(Filter().Kind("API")).Eq("spec.owner", "group:default/team-a"),
+			expected: []string{"kind=Component,spec.owner=group:default/team-a", "kind=API,spec.owner=group:default/team-a"},
+		},
+		{
+			name:     "or with empty filter",
+			filter:   Filter().Or(Filter().Kind("API")),
+			expected: nil,
+		},
+		{
+			name:     "or empty filter",
+			filter:   Filter().Kind("X").Or(Filter()),
+			expected: nil,
+		},
+		{
+			name:     "and after or with empty filter",
+			filter:   Filter().Or(Filter().Kind("API")).Eq("spec.owner", "group:default/team-a"),
+			expected: []string{"spec.owner=group:default/team-a", "kind=API,spec.owner=group:default/team-a"},
+		},
+		{
+			name:     "value with equals sign",
+			filter:   Filter().Eq("metadata.annotations.example.com/query", "a=b"),
+			expected: []string{"metadata.annotations.example.com/query=a=b"},
+		},
+		{
+			name:          "value with comma",
+			filter:        Filter().Eq("metadata.name", "a,b"),
+			errorContains: "contains a comma",
+		},
+		{
+			name:          "key with equals sign",
+			filter:        Filter().Exists("a=b"),
+			errorContains: "must not contain",
+		},
+		{
+			name:          "empty key",
+			filter:        Filter().Eq("", "foo"),
+			errorContains: "empty key",
+		},
+		{
+			name:          "no values",
+			filter:        Filter().In("kind"),
+			errorContains: "no values",
+		},
+		{
+			name:          "error in alternative",
+			filter:        Filter().Kind("Component").Or(Filter().Eq("", "foo")),
+			errorContains: "empty key",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.filter.Build()
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.expected, actual)
+		})
+	}
+}
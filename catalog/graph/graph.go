@@ -0,0 +1,290 @@
+// Package graph provides an in-memory graph of catalog entities, built from their relations.
+package graph
+
+import (
+	"slices"
+	"strings"
+
+	"go.einride.tech/backstage/catalog"
+)
+
+// Graph is a directed graph of catalog entities, indexed by entity ref.
+//
+// Nodes are entities and edges are relations. Relation targets that are not part of the graph's entities are still
+// nodes in the graph, without an entity.
+type Graph struct {
+	nodes map[string]*node
+	// keys of the nodes, in insertion order.
+	keys []string
+	// edges of the graph, for removing duplicate edges.
+	edges map[edge]struct{}
+}
+
+type node struct {
+	ref    catalog.EntityRef
+	entity *catalog.Entity
+	// edges of the node, in insertion order.
+	edges []edge
+	// targets of the node's edges by relation type, in insertion order.
+	targets map[catalog.RelationType][]string
+	// allTargets are the distinct targets of the node's edges of all relation types, in insertion order.
+	allTargets []string
+	// allTargetsSet contains the keys of allTargets.
+	allTargetsSet map[string]struct{}
+}
+
+type edge struct {
+	source       string
+	relationType catalog.RelationType
+	target       string
+}
+
+// New builds a graph from the relations of the provided entities.
+//
+// Inverse relations of known relation types are added when missing, so that a relation only needs to be present on
+// one of the related entities.
+func New(entities []*catalog.Entity) *Graph {
+	g := &Graph{nodes: map[string]*node{}, edges: map[edge]struct{}{}}
+	for _, entity := range entities {
+		g.node(entity.Ref()).entity = entity
+	}
+	for _, entity := range entities {
+		source := g.node(entity.Ref())
+		for _, relation := range entity.Relations {
+			targetRef, err := relation.TargetEntityRef()
+			if err != nil {
+				continue
+			}
+			target := g.node(targetRef)
+			g.addEdge(source, relation.Type, target)
+			if inverse, ok := relation.Type.Inverse(); ok {
+				g.addEdge(target, inverse, source)
+			}
+		}
+	}
+	return g
+}
+
+// Entity returns the entity with the provided ref, if part of the graph.
+func (g *Graph) Entity(ref catalog.EntityRef) (*catalog.Entity, bool) {
+	n, ok := g.nodes[key(ref)]
+	if !ok || n.entity == nil {
+		return nil, false
+	}
+	return n.entity, true
+}
+
+// Refs returns the refs of all nodes in the graph, including relation targets without entities.
+func (g *Graph) Refs() []catalog.EntityRef {
+	result := make([]catalog.EntityRef, 0, len(g.keys))
+	for _, k := range g.keys {
+		result = append(result, g.nodes[k].ref)
+	}
+	return result
+}
+
+// Neighbors returns the refs of the direct relation targets of an entity.
+//
+// Only relations of the provided types are followed, or all relations if no types are provided.
+func (g *Graph) Neighbors(ref catalog.EntityRef, relationTypes ...catalog.RelationType) []catalog.EntityRef {
+	n, ok := g.nodes[key(ref)]
+	if !ok {
+		return nil
+	}
+	var result []catalog.EntityRef
+	for _, target := range g.targets(n, relationTypes) {
+		result = append(result, g.nodes[target].ref)
+	}
+	return result
+}
+
+// Transitive returns the refs of all entities transitively reachable from an entity, in breadth-first order.
+//
+// For example, everything a System transitively depends on. The entity itself is only included if reachable through
+// a cycle. Only relations of the provided types are followed, or all relations if no types are provided.
+func (g *Graph) Transitive(ref catalog.EntityRef, relationTypes ...catalog.RelationType) []catalog.EntityRef {
+	start, ok := g.nodes[key(ref)]
+	if !ok {
+		return nil
+	}
+	var result []catalog.EntityRef
+	visited := map[string]bool{}
+	queue := []*node{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, target := range g.targets(current, relationTypes) {
+			if visited[target] {
+				continue
+			}
+			visited[target] = true
+			result = append(result, g.nodes[target].ref)
+			queue = append(queue, g.nodes[target])
+		}
+	}
+	return result
+}
+
+// ShortestPath returns the refs of the entities on a shortest path between two entities, including both ends.
+//
+// Returns false if there is no path. Only relations of the provided types are followed, or all relations if no types
+// are provided.
+func (g *Graph) ShortestPath(
+	from catalog.EntityRef,
+	to catalog.EntityRef,
+	relationTypes ...catalog.RelationType,
+) ([]catalog.EntityRef, bool) {
+	fromKey, toKey := key(from), key(to)
+	start, ok := g.nodes[fromKey]
+	if !ok {
+		return nil, false
+	}
+	if _, ok := g.nodes[toKey]; !ok {
+		return nil, false
+	}
+	previous := map[string]string{fromKey: ""}
+	queue := []*node{start}
+	for len(queue) > 0 && !hasKey(previous, toKey) {
+		current := queue[0]
+		queue = queue[1:]
+		currentKey := key(current.ref)
+		for _, target := range g.targets(current, relationTypes) {
+			if hasKey(previous, target) {
+				continue
+			}
+			previous[target] = currentKey
+			queue = append(queue, g.nodes[target])
+		}
+	}
+	if !hasKey(previous, toKey) {
+		return nil, false
+	}
+	var path []catalog.EntityRef
+	for k := toKey; k != ""; k = previous[k] {
+		path = append(path, g.nodes[k].ref)
+	}
+	slices.Reverse(path)
+	return path, true
+}
+
+// Cycles returns the cycles in the graph, as groups of entities that can all reach each other.
+//
+// Since every known relation type has an inverse, cycle detection is only meaningful when following relations in one
+// direction, e.g. [catalog.RelationDependsOn]. Only relations of the provided types are followed, or all relations if
+// no types are provided.
+func (g *Graph) Cycles(relationTypes ...catalog.RelationType) [][]catalog.EntityRef {
+	// Tarjan's strongly connected components algorithm.
+	var (
+		index    int
+		indices  = map[string]int{}
+		lowLinks = map[string]int{}
+		onStack  = map[string]bool{}
+		stack    []string
+		result   [][]catalog.EntityRef
+	)
+	var strongConnect func(k string)
+	strongConnect = func(k string) {
+		indices[k] = index
+		lowLinks[k] = index
+		index++
+		stack = append(stack, k)
+		onStack[k] = true
+		selfLoop := false
+		for _, target := range g.targets(g.nodes[k], relationTypes) {
+			if target == k {
+				selfLoop = true
+			}
+			if _, visited := indices[target]; !visited {
+				strongConnect(target)
+				lowLinks[k] = min(lowLinks[k], lowLinks[target])
+			} else if onStack[target] {
+				lowLinks[k] = min(lowLinks[k], indices[target])
+			}
+		}
+		if lowLinks[k] != indices[k] {
+			return
+		}
+		var component []catalog.EntityRef
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, g.nodes[top].ref)
+			if top == k {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			slices.Reverse(component)
+			result = append(result, component)
+		}
+	}
+	for _, k := range g.keys {
+		if _, visited := indices[k]; !visited {
+			strongConnect(k)
+		}
+	}
+	return result
+}
+
+func (g *Graph) node(ref catalog.EntityRef) *node {
+	k := key(ref)
+	if n, ok := g.nodes[k]; ok {
+		return n
+	}
+	n := &node{ref: ref}
+	g.nodes[k] = n
+	g.keys = append(g.keys, k)
+	return n
+}
+
+func (g *Graph) addEdge(source *node, relationType catalog.RelationType, target *node) {
+	e := edge{source: key(source.ref), relationType: relationType, target: key(target.ref)}
+	if _, ok := g.edges[e]; ok {
+		return
+	}
+	g.edges[e] = struct{}{}
+	source.edges = append(source.edges, e)
+	if source.targets == nil {
+		source.targets = map[catalog.RelationType][]string{}
+		source.allTargetsSet = map[string]struct{}{}
+	}
+	source.targets[relationType] = append(source.targets[relationType], e.target)
+	if _, ok := source.allTargetsSet[e.target]; !ok {
+		source.allTargetsSet[e.target] = struct{}{}
+		source.allTargets = append(source.allTargets, e.target)
+	}
+}
+
+// targets returns the keys of the distinct targets of a node's edges of the provided relation types.
+//
+// The returned slice must not be modified.
+func (g *Graph) targets(n *node, relationTypes []catalog.RelationType) []string {
+	switch len(relationTypes) {
+	case 0:
+		return n.allTargets
+	case 1:
+		return n.targets[relationTypes[0]]
+	}
+	var result []string
+	seen := make(map[string]struct{}, len(n.allTargets))
+	for _, e := range n.edges {
+		if !slices.Contains(relationTypes, e.relationType) {
+			continue
+		}
+		if _, ok := seen[e.target]; !ok {
+			seen[e.target] = struct{}{}
+			result = append(result, e.target)
+		}
+	}
+	return result
+}
+
+func key(ref catalog.EntityRef) string {
+	return strings.ToLower(ref.String())
+}
+
+func hasKey(m map[string]string, k string) bool {
+	_, ok := m[k]
+	return ok
+}
@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"go.einride.tech/backstage/catalog"
+	"gotest.tools/v3/assert"
+)
+
+func TestGraph(t *testing.T) {
+	// A system with two components, where the API component depends on a database, which in turn depends on a
+	// storage resource outside the set of entities.
+	//nolint: lll
+	const entities = `[
+{"kind":"System","metadata":{"name":"podcast"},"relations":[{"type":"hasPart","targetRef":"component:default/podcast-api"},{"type":"hasPart","targetRef":"component:default/podcast-web"},{"type":"ownedBy","targetRef":"group:default/team-a"}]},
+{"kind":"Component","metadata":{"name":"podcast-api"},"relations":[{"type":"partOf","targetRef":"system:default/podcast"},{"type":"dependsOn","targetRef":"resource:default/podcast-db"}]},
+{"kind":"Component","metadata":{"name":"podcast-web"},"relations":[{"type":"partOf","targetRef":"system:default/podcast"},{"type":"dependsOn","targetRef":"component:default/podcast-api"}]},
+{"kind":"Resource","metadata":{"name":"podcast-db"},"relations":[{"type":"dependsOn","targetRef":"resource:default/storage"}]},
+{"kind":"Group","metadata":{"name":"team-a"}}
+]`
+	var input []*catalog.Entity
+	assert.NilError(t, json.Unmarshal([]byte(entities), &input))
+	g := New(input)
+	ref := func(s string) catalog.EntityRef {
+		t.Helper()
+		result, err := catalog.ParseEntityRef(s, catalog.EntityRefDefaults{})
+		assert.NilError(t, err)
+		return result
+	}
+
+	t.Run("entity", func(t *testing.T) {
+		entity, ok := g.Entity(ref("Component:podcast-api"))
+		assert.Assert(t, ok)
+		assert.Equal(t, "podcast-api", entity.Metadata.Name)
+		_, ok = g.Entity(ref("resource:storage"))
+		assert.Assert(t, !ok, "relation targets without entities have no entity")
+		_, ok = g.Entity(ref("component:missing"))
+		assert.Assert(t, !ok)
+	})
+
+	t.Run("refs", func(t *testing.T) {
+		assert.Equal(t, 6, len(g.Refs()))
+	})
+
+	t.Run("neighbors", func(t *testing.T) {
+		assert.DeepEqual(t, []catalog.EntityRef{
+			ref("component:podcast-api"),
+			ref("component:podcast-web"),
+		}, g.Neighbors(ref("system:podcast"), catalog.RelationHasPart))
+		assert.DeepEqual(t, []catalog.EntityRef{
+			ref("component:podcast-api"),
+			ref("component:podcast-web"),
+			ref("group:team-a"),
+		}, g.Neighbors(ref("system:podcast")))
+		assert.Assert(t, g.Neighbors(ref("component:missing")) == nil)
+		// A target related with several of the relation types is only returned once.
+		assert.DeepEqual(t, []catalog.EntityRef{
+			ref("system:podcast"),
+			ref("resource:podcast-db"),
+			ref("component:podcast-web"),
+		}, g.Neighbors(
+			ref("component:podcast-api"),
+			catalog.RelationDependsOn,
+			catalog.RelationDependencyOf,
+			catalog.RelationPartOf,
+		))
+	})
+
+	t.Run("inverse relations", func(t *testing.T) {
+		assert.DeepEqual(t, []catalog.EntityRef{
+			ref("system:podcast"),
+		}, g.Neighbors(ref("group:team-a"), catalog.RelationOwnerOf))
+		assert.DeepEqual(t, []catalog.EntityRef{
+			ref("resource:podcast-db"),
+		}, g.Neighbors(ref("resource:storage"), catalog.RelationDependencyOf))
+	})
+
+	t.Run("transitive", func(t *testing.T) {
+		assert.DeepEqual(t, []catalog.EntityRef{
+			ref("component:podcast-api"),
+			ref("component:podcast-web"),
+			ref("resource:podcast-db"),
+			ref("resource:storage"),
+		}, g.Transitive(ref("system:podcast"), catalog.RelationHasPart, catalog.RelationDependsOn))
+		assert.DeepEqual(t, []catalog.EntityRef{
+			ref("resource:podcast-db"),
+			ref("component:podcast-api"),
+			ref("component:podcast-web"),
+		}, g.Transitive(ref("resource:storage"), catalog.RelationDependencyOf))
+	})
+
+	t.Run("shortest path", func(t *testing.T) {
+		path, ok := g.ShortestPath(ref("component:podcast-web"), ref("resource:storage"), catalog.RelationDependsOn)
+		assert.Assert(t, ok)
+		assert.DeepEqual(t, []catalog.EntityRef{
+			ref("component:podcast-web"),
+			ref("component:podcast-api"),
+			ref("resource:podcast-db"),
+			ref("resource:storage"),
+		}, path)
+		path, ok = g.ShortestPath(ref("group:team-a"), ref("resource:podcast-db"))
+		assert.Assert(t, ok)
+		assert.DeepEqual(t, []catalog.EntityRef{
+			ref("group:team-a"),
+			ref("system:podcast"),
+			ref("component:podcast-api"),
+			ref("resource:podcast-db"),
+		}, path)
+		path, ok = g.ShortestPath(ref("group:team-a"), ref("group:team-a"))
+		assert.Assert(t, ok)
+		assert.DeepEqual(t, []catalog.EntityRef{ref("group:team-a")}, path)
+		_, ok = g.ShortestPath(ref("resource:storage"), ref("component:podcast-web"), catalog.RelationDependsOn)
+		assert.Assert(t, !ok)
+		_, ok = g.ShortestPath(ref("component:missing"), ref("component:podcast-web"))
+		assert.Assert(t, !ok)
+	})
+
+	t.Run("no cycles", func(t *testing.T) {
+		assert.Assert(t, g.Cycles(catalog.RelationDependsOn) == nil)
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		//nolint: lll
+		const cyclic = `[
+{"kind":"Component","metadata":{"name":"a"},"relations":[{"type":"dependsOn","targetRef":"component:default/b"}]},
+{"kind":"Component","metadata":{"name":"b"},"relations":[{"type":"dependsOn","targetRef":"component:default/c"}]},
+{"kind":"Component","metadata":{"name":"c"},"relations":[{"type":"dependsOn","targetRef":"component:default/a"},{"type":"dependsOn","targetRef":"component:default/d"}]},
+{"kind":"Component","metadata":{"name":"d"},"relations":[{"type":"dependsOn","targetRef":"component:default/d"}]},
+{"kind":"Component","metadata":{"name":"e"},"relations":[{"type":"dependsOn","targetRef":"component:default/a"}]}
+]`
+		var input []*catalog.Entity
+		assert.NilError(t, json.Unmarshal([]byte(cyclic), &input))
+		assert.DeepEqual(t, [][]catalog.EntityRef{
+			{ref("component:d")},
+			{ref("component:a"), ref("component:b"), ref("component:c")},
+		}, New(input).Cycles(catalog.RelationDependsOn))
+	})
+}
+
+func BenchmarkGraph_hub(b *testing.B) {
+	// A group that owns many components, as in large catalogs.
+	const n = 20000
+	entities := make([]*catalog.Entity, 0, n+1)
+	entities = append(entities, &catalog.Entity{
+		Kind:     catalog.EntityKindGroup,
+		Metadata: catalog.EntityMetadata{Name: "hub"},
+	})
+	for i := range n {
+		entities = append(entities, &catalog.Entity{
+			Kind:     catalog.EntityKindComponent,
+			Metadata: catalog.EntityMetadata{Name: fmt.Sprintf("component-%d", i)},
+			Relations: []catalog.EntityRelation{
+				{Type: catalog.RelationOwnedBy, TargetRef: "group:default/hub"},
+			},
+		})
+	}
+	b.ResetTimer()
+	for range b.N {
+		g := New(entities)
+		hub := catalog.EntityRef{Kind: "group", Namespace: catalog.DefaultNamespace, Name: "hub"}
+		if refs := g.Transitive(hub, catalog.RelationOwnerOf); len(refs) != n {
+			b.Fatalf("expected %d refs but got %d", n, len(refs))
+		}
+	}
+}
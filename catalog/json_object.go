@@ -0,0 +1,146 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// jsonObject is a JSON object that preserves the order of its keys.
+type jsonObject struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+func newJSONObject() *jsonObject {
+	return &jsonObject{values: map[string]json.RawMessage{}}
+}
+
+func parseJSONObject(data []byte) (*jsonObject, error) {
+	result := newJSONObject()
+	if len(bytes.TrimSpace(data)) == 0 || bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return result, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if err := expectDelim(decoder, '{'); err != nil {
+		return nil, err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected object key but got %v", token)
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		result.set(key, value)
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (o *jsonObject) get(key string) json.RawMessage {
+	return o.values[key]
+}
+
+func (o *jsonObject) set(key string, value json.RawMessage) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+func (o *jsonObject) setValue(key string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	o.set(key, data)
+	return nil
+}
+
+func (o *jsonObject) delete(key string) {
+	if _, ok := o.values[key]; !ok {
+		return
+	}
+	delete(o.values, key)
+	o.keys = slices.DeleteFunc(o.keys, func(k string) bool {
+		return k == key
+	})
+}
+
+// mergeStruct replaces the fields of the object that correspond to the JSON fields of the struct v.
+//
+// Fields of v that are omitted when marshaled are deleted from the object, and other keys are preserved.
+func (o *jsonObject) mergeStruct(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	typed, err := parseJSONObject(data)
+	if err != nil {
+		return err
+	}
+	for _, key := range jsonFieldNames(reflect.TypeOf(v)) {
+		if value, ok := typed.values[key]; ok {
+			o.set(key, value)
+		} else {
+			o.delete(key)
+		}
+	}
+	return nil
+}
+
+// jsonFieldNames returns the JSON field names of the struct type t, including fields of embedded structs.
+func jsonFieldNames(t reflect.Type) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var result, embedded []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch {
+		case key == "" && field.Anonymous:
+			embedded = append(embedded, jsonFieldNames(field.Type)...)
+		case key != "" && key != "-":
+			result = append(result, key)
+		}
+	}
+	// Fields of the outer struct shadow fields of embedded structs.
+	for _, key := range embedded {
+		if !slices.Contains(result, key) {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+func (o *jsonObject) MarshalJSON() ([]byte, error) {
+	var result bytes.Buffer
+	_ = result.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			_ = result.WriteByte(',')
+		}
+		keyData, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		_, _ = result.Write(keyData)
+		_ = result.WriteByte(':')
+		_, _ = result.Write(o.values[key])
+	}
+	_ = result.WriteByte('}')
+	return result.Bytes(), nil
+}
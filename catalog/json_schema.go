@@ -0,0 +1,347 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// jsonSchema is the subset of JSON Schema (draft 7) used by template parameters.
+//
+// Annotations and extension keywords, such as ui:* options, are ignored. Any other keyword outside the subset is
+// rejected when the schema is decoded, so that values are never reported as valid against keywords that aren't
+// validated.
+type jsonSchema struct {
+	Type         jsonSchemaTypes                  `json:"type"`
+	Properties   map[string]*jsonSchema           `json:"properties"`
+	Required     []string                         `json:"required"`
+	Dependencies map[string]*jsonSchemaDependency `json:"dependencies"`
+	Items        *jsonSchema                      `json:"items"`
+	Enum         []any                            `json:"enum"`
+	Const        json.RawMessage                  `json:"const"`
+	AllOf        []*jsonSchema                    `json:"allOf"`
+	AnyOf        []*jsonSchema                    `json:"anyOf"`
+	OneOf        []*jsonSchema                    `json:"oneOf"`
+	MinLength    *int                             `json:"minLength"`
+	MaxLength    *int                             `json:"maxLength"`
+	Pattern      string                           `json:"pattern"`
+	Minimum      *float64                         `json:"minimum"`
+	Maximum      *float64                         `json:"maximum"`
+	MinItems     *int                             `json:"minItems"`
+	MaxItems     *int                             `json:"maxItems"`
+	UniqueItems  bool                             `json:"uniqueItems"`
+
+	// discriminator is the name of the property that selects between oneOf and anyOf schemas, when the schema is the
+	// dependency schema of that property.
+	discriminator string
+}
+
+// jsonSchemaAnnotations are the keywords of JSON Schema that don't affect validation.
+var jsonSchemaAnnotations = []string{
+	"$schema", "$id", "$comment", "title", "description", "default", "examples", "readOnly", "writeOnly",
+	// Display names of enum values, from react-jsonschema-form.
+	"enumNames",
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (s *jsonSchema) UnmarshalJSON(data []byte) error {
+	var keywords map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keywords); err != nil {
+		return fmt.Errorf("unsupported schema %s: %w", data, err)
+	}
+	supported := jsonFieldNames(reflect.TypeFor[jsonSchema]())
+	for _, keyword := range slices.Sorted(maps.Keys(keywords)) {
+		// Keywords with a colon, such as ui:field, are extensions and not part of JSON Schema.
+		if strings.Contains(keyword, ":") ||
+			slices.Contains(jsonSchemaAnnotations, keyword) ||
+			slices.Contains(supported, keyword) {
+			continue
+		}
+		return fmt.Errorf("unsupported keyword %q", keyword)
+	}
+	type plainJSONSchema jsonSchema
+	if err := json.Unmarshal(data, (*plainJSONSchema)(s)); err != nil {
+		return err
+	}
+	// Patterns are ECMA-262 regular expressions, of which RE2 supports a subset without features such as lookarounds
+	// and backreferences.
+	if s.Pattern != "" {
+		if _, err := regexp.Compile(s.Pattern); err != nil {
+			return fmt.Errorf("unsupported pattern %q: %w", s.Pattern, err)
+		}
+	}
+	return nil
+}
+
+// jsonSchemaDependency is a dependency of a property, which is either a list of required properties or a schema.
+type jsonSchemaDependency struct {
+	required []string
+	schema   *jsonSchema
+}
+
+func (d *jsonSchemaDependency) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		return json.Unmarshal(data, &d.required)
+	}
+	return json.Unmarshal(data, &d.schema)
+}
+
+// jsonSchemaTypes is the type keyword of a JSON Schema, which is either a single type or a list of types.
+type jsonSchemaTypes []string
+
+func (t *jsonSchemaTypes) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var single string
+		if err := json.Unmarshal(data, &single); err != nil {
+			return err
+		}
+		*t = jsonSchemaTypes{single}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(t))
+}
+
+// jsonSchemaError is an error for a value at a dot-separated path.
+type jsonSchemaError struct {
+	path    string
+	message string
+}
+
+// validate validates the value v, decoded from JSON, and returns all errors found.
+func (s *jsonSchema) validate(path string, v any) ([]jsonSchemaError, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var errs []jsonSchemaError
+	addError := func(format string, args ...any) {
+		errs = append(errs, jsonSchemaError{path: path, message: fmt.Sprintf(format, args...)})
+	}
+	if len(s.Type) > 0 && !slices.ContainsFunc(s.Type, func(t string) bool { return jsonSchemaTypeMatches(t, v) }) {
+		addError("must be of type %s", strings.Join(s.Type, " or "))
+		// Further keywords are type-specific.
+		return errs, nil
+	}
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return reflect.DeepEqual(e, v) }) {
+		addError("must be one of %s", formatJSONValues(s.Enum))
+	}
+	if len(s.Const) > 0 {
+		var c any
+		if err := json.Unmarshal(s.Const, &c); err != nil {
+			return nil, fmt.Errorf("%s: invalid const: %w", path, err)
+		}
+		if !reflect.DeepEqual(c, v) {
+			addError("must be %s", s.Const)
+		}
+	}
+	switch v := v.(type) {
+	case string:
+		if s.MinLength != nil && utf8.RuneCountInString(v) < *s.MinLength {
+			addError("must be at least %d characters long", *s.MinLength)
+		}
+		if s.MaxLength != nil && utf8.RuneCountInString(v) > *s.MaxLength {
+			addError("must be at most %d characters long", *s.MaxLength)
+		}
+		if s.Pattern != "" {
+			pattern, err := regexp.Compile(s.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("%s: unsupported pattern %q: %w", path, s.Pattern, err)
+			}
+			if !pattern.MatchString(v) {
+				addError("must match pattern %q", s.Pattern)
+			}
+		}
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			addError("must be at least %v", *s.Minimum)
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			addError("must be at most %v", *s.Maximum)
+		}
+	case []any:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			addError("must have at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			addError("must have at most %d items", *s.MaxItems)
+		}
+		if s.UniqueItems {
+			for i := range v {
+				if slices.ContainsFunc(v[:i], func(e any) bool { return reflect.DeepEqual(e, v[i]) }) {
+					addError("must have unique items")
+					break
+				}
+			}
+		}
+		for i, item := range v {
+			itemErrs, err := s.Items.validate(joinJSONSchemaPath(path, strconv.Itoa(i)), item)
+			if err != nil {
+				return nil, err
+			}
+			errs = append(errs, itemErrs...)
+		}
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				errs = append(errs, jsonSchemaError{path: joinJSONSchemaPath(path, name), message: "is required"})
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(s.Properties)) {
+			if value, ok := v[name]; ok {
+				propertyErrs, err := s.Properties[name].validate(joinJSONSchemaPath(path, name), value)
+				if err != nil {
+					return nil, err
+				}
+				errs = append(errs, propertyErrs...)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(s.Dependencies)) {
+			if _, ok := v[name]; !ok {
+				continue
+			}
+			dependencyErrs, err := s.validateDependency(path, name, v)
+			if err != nil {
+				return nil, err
+			}
+			errs = append(errs, dependencyErrs...)
+		}
+	}
+	compositionErrs, err := s.validateComposition(path, v)
+	if err != nil {
+		return nil, err
+	}
+	return append(errs, compositionErrs...), nil
+}
+
+func (s *jsonSchema) validateDependency(path, name string, v map[string]any) ([]jsonSchemaError, error) {
+	dependency := s.Dependencies[name]
+	if dependency.schema == nil {
+		var errs []jsonSchemaError
+		for _, requiredName := range dependency.required {
+			if _, ok := v[requiredName]; !ok {
+				errs = append(errs, jsonSchemaError{
+					path:    joinJSONSchemaPath(path, requiredName),
+					message: fmt.Sprintf("is required when %s is set", joinJSONSchemaPath(path, name)),
+				})
+			}
+		}
+		return errs, nil
+	}
+	dependencySchema := *dependency.schema
+	dependencySchema.discriminator = joinJSONSchemaPath(path, name)
+	return dependencySchema.validate(path, v)
+}
+
+func (s *jsonSchema) validateComposition(path string, v any) ([]jsonSchemaError, error) {
+	var errs []jsonSchemaError
+	for _, schema := range s.AllOf {
+		allOfErrs, err := schema.validate(path, v)
+		if err != nil {
+			return nil, err
+		}
+		errs = append(errs, allOfErrs...)
+	}
+	if len(s.AnyOf) > 0 {
+		anyOfErrs, matches, err := validateAlternatives(path, s.AnyOf, v, s.discriminator)
+		if err != nil {
+			return nil, err
+		}
+		if matches == 0 {
+			errs = append(errs, anyOfErrs...)
+		}
+	}
+	if len(s.OneOf) > 0 {
+		oneOfErrs, matches, err := validateAlternatives(path, s.OneOf, v, s.discriminator)
+		if err != nil {
+			return nil, err
+		}
+		switch matches {
+		case 0:
+			errs = append(errs, oneOfErrs...)
+		case 1:
+		default:
+			errs = append(errs, jsonSchemaError{path: path, message: "must match exactly one schema in oneOf"})
+		}
+	}
+	return errs, nil
+}
+
+// validateAlternatives validates v against each of the schemas and returns the number of matching schemas.
+//
+// When no schema matches, the errors of the closest schema are returned. The closest schema is the one with the
+// fewest errors, preferring schemas that accept the value of the discriminator property. For the common pattern of
+// dependencies with oneOf, this is the schema selected by the dependency's value.
+func validateAlternatives(
+	path string,
+	schemas []*jsonSchema,
+	v any,
+	discriminator string,
+) ([]jsonSchemaError, int, error) {
+	var closestErrs []jsonSchemaError
+	var closestDiscriminated bool
+	var matches int
+	for _, schema := range schemas {
+		errs, err := schema.validate(path, v)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(errs) == 0 {
+			matches++
+			continue
+		}
+		discriminated := discriminator != "" && !slices.ContainsFunc(errs, func(e jsonSchemaError) bool {
+			return e.path == discriminator
+		})
+		switch {
+		case closestErrs == nil,
+			discriminated && !closestDiscriminated,
+			discriminated == closestDiscriminated && len(errs) < len(closestErrs):
+			closestErrs, closestDiscriminated = errs, discriminated
+		}
+	}
+	return closestErrs, matches, nil
+}
+
+func jsonSchemaTypeMatches(t string, v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return t == "null"
+	case bool:
+		return t == "boolean"
+	case float64:
+		return t == "number" || t == "integer" && v == math.Trunc(v)
+	case string:
+		return t == "string"
+	case []any:
+		return t == "array"
+	case map[string]any:
+		return t == "object"
+	}
+	return false
+}
+
+func joinJSONSchemaPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func formatJSONValues(values []any) string {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			formatted = append(formatted, fmt.Sprint(value))
+			continue
+		}
+		formatted = append(formatted, string(data))
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,15 @@
+package catalog
+
+// Location is a location registered in the catalog, from which entities are read.
+//
+// See: https://backstage.io/docs/features/software-catalog/software-catalog-api/#locations
+type Location struct {
+	// ID of the location.
+	ID string `json:"id"`
+
+	// Type of the location, e.g. "url".
+	Type string `json:"type"`
+
+	// Target of the location, e.g. the URL to a catalog-info.yaml file.
+	Target string `json:"target"`
+}
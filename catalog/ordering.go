@@ -1,7 +1,36 @@
 package catalog
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Ordering configuration for a field.
 type Ordering struct {
 	Field string
 	Desc  bool
 }
+
+// String returns the ordering in the "<field>,<asc|desc>" format used by the catalog API.
+func (o Ordering) String() string {
+	if o.Desc {
+		return o.Field + ",desc"
+	}
+	return o.Field + ",asc"
+}
+
+// ParseOrdering parses an ordering in the "<field>[,<asc|desc>]" format used by the catalog API.
+func ParseOrdering(s string) (Ordering, error) {
+	field, order, _ := strings.Cut(s, ",")
+	if field == "" {
+		return Ordering{}, fmt.Errorf("parse ordering %q: missing field", s)
+	}
+	switch strings.ToLower(order) {
+	case "", "asc":
+		return Ordering{Field: field}, nil
+	case "desc":
+		return Ordering{Field: field, Desc: true}, nil
+	default:
+		return Ordering{}, fmt.Errorf("parse ordering %q: invalid order %q", s, order)
+	}
+}
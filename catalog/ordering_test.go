@@ -0,0 +1,34 @@
+package catalog
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseOrdering(t *testing.T) {
+	for _, tt := range []struct {
+		input         string
+		expected      Ordering
+		errorContains string
+	}{
+		{input: "metadata.name", expected: Ordering{Field: "metadata.name"}},
+		{input: "metadata.name,asc", expected: Ordering{Field: "metadata.name"}},
+		{input: "metadata.name,DESC", expected: Ordering{Field: "metadata.name", Desc: true}},
+		{input: ",desc", errorContains: "missing field"},
+		{input: "metadata.name,up", errorContains: "invalid order"},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			actual, err := ParseOrdering(tt.input)
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, actual)
+			roundTripped, err := ParseOrdering(actual.String())
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, roundTripped)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package catalog
+
+import (
+	"time"
+
+	"go.einride.tech/backstage/internal/backstagehttp"
+)
+
+// RetryPolicy configures retries of failed requests.
+//
+// Zero values of the backoff and status code fields are replaced with sensible defaults.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including the first attempt.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the maximum backoff before the first retry. Defaults to 100 milliseconds.
+	//
+	// The maximum backoff is doubled for every retry, and the actual backoff is chosen randomly up to the maximum.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the maximum backoff between retries. Defaults to 10 seconds.
+	MaxBackoff time.Duration
+
+	// RetryableStatusCodes are the HTTP status codes to retry. Defaults to 429, 502, 503 and 504.
+	RetryableStatusCodes []int
+
+	// RetryNonIdempotent enables retries of requests with non-idempotent methods, such as POST.
+	RetryNonIdempotent bool
+}
+
+// WithRetryPolicy sets the policy for retrying failed requests.
+//
+// Requests are retried on retryable status codes and on transport errors. Retry-After headers from the server
+// take precedence over the backoff of the policy. Retries stop when the request context is done.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(config *backstagehttp.Config) {
+		config.RetryPolicy = backstagehttp.RetryPolicy(policy)
+	}
+}
@@ -0,0 +1,149 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_retryPolicy(t *testing.T) {
+	ctx := context.Background()
+	policy := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}
+
+	t.Run("retry until success", func(t *testing.T) {
+		var attempts atomic.Int64
+		client := newRetryTestClient(t, policy, func(w http.ResponseWriter, _ *http.Request) {
+			if attempts.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte("{}"))
+		})
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.NilError(t, err)
+		assert.Equal(t, int64(3), attempts.Load())
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		var attempts atomic.Int64
+		client := newRetryTestClient(t, policy, func(w http.ResponseWriter, _ *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, http.StatusTooManyRequests, errStatus.StatusCode)
+		assert.Equal(t, int64(3), attempts.Load())
+	})
+
+	t.Run("non-retryable status code", func(t *testing.T) {
+		var attempts atomic.Int64
+		client := newRetryTestClient(t, policy, func(w http.ResponseWriter, _ *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.ErrorContains(t, err, "500")
+		assert.Equal(t, int64(1), attempts.Load())
+	})
+
+	t.Run("custom retryable status codes", func(t *testing.T) {
+		var attempts atomic.Int64
+		policy := policy
+		policy.RetryableStatusCodes = []int{http.StatusInternalServerError}
+		client := newRetryTestClient(t, policy, func(w http.ResponseWriter, _ *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.ErrorContains(t, err, "500")
+		assert.Equal(t, int64(3), attempts.Load())
+	})
+
+	t.Run("non-idempotent method", func(t *testing.T) {
+		var attempts atomic.Int64
+		client := newRetryTestClient(t, policy, func(w http.ResponseWriter, _ *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		_, err := client.BatchGetEntitiesByRefs(ctx, &BatchGetEntitiesByRefsRequest{EntityRefs: []string{"foo"}})
+		assert.ErrorContains(t, err, "503")
+		assert.Equal(t, int64(1), attempts.Load())
+	})
+
+	t.Run("retry non-idempotent method", func(t *testing.T) {
+		var bodies []string
+		policy := policy
+		policy.RetryNonIdempotent = true
+		client := newRetryTestClient(t, policy, func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NilError(t, err)
+			bodies = append(bodies, string(body))
+			if len(bodies) < 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"items":[]}`))
+		})
+		_, err := client.BatchGetEntitiesByRefs(ctx, &BatchGetEntitiesByRefsRequest{EntityRefs: []string{"foo"}})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, []string{`{"entityRefs":["foo"]}`, `{"entityRefs":["foo"]}`}, bodies)
+	})
+
+	t.Run("retry after", func(t *testing.T) {
+		var attempts atomic.Int64
+		policy := policy
+		policy.InitialBackoff = time.Hour
+		policy.MaxBackoff = time.Hour
+		client := newRetryTestClient(t, policy, func(w http.ResponseWriter, _ *http.Request) {
+			if attempts.Add(1) < 2 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte("{}"))
+		})
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.NilError(t, err)
+		assert.Equal(t, int64(2), attempts.Load())
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		policy := policy
+		policy.InitialBackoff = time.Hour
+		policy.MaxBackoff = time.Hour
+		client := newRetryTestClient(t, policy, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err := client.GetEntityByUID(ctx, &GetEntityByUIDRequest{UID: "test"})
+		assert.Assert(t, errors.Is(err, context.DeadlineExceeded))
+	})
+}
+
+func newRetryTestClient(
+	t *testing.T,
+	policy RetryPolicy,
+	handler func(http.ResponseWriter, *http.Request),
+) *Client {
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(server.Close)
+	return NewClient(
+		WithBaseURL(server.URL),
+		WithToken(testToken),
+		WithRetryPolicy(policy),
+	)
+}
@@ -1,6 +1,22 @@
 package catalog
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Known Template API versions.
+const (
+	// TemplateAPIVersionV1beta2 is the legacy Template API version, using Handlebars templating.
+	TemplateAPIVersionV1beta2 = "backstage.io/v1beta2"
+
+	// TemplateAPIVersionV1beta3 is the current Template API version, using Nunjucks templating.
+	TemplateAPIVersionV1beta3 = "scaffolder.backstage.io/v1beta3"
+)
 
 // TemplateSpec contains the Template standard spec fields.
 //
@@ -14,8 +30,341 @@ type TemplateSpec struct {
 	Owner string `json:"owner,omitempty"`
 
 	// RawParameters contains the parameter specs.
+	//
+	// A single parameter spec object is normalized to a list with one parameter spec.
 	RawParameters []json.RawMessage `json:"parameters"`
 
 	// RawSteps contains the step specs.
 	RawSteps []json.RawMessage `json:"steps"`
+
+	// Output of the template, shown to the user when the template has been executed.
+	Output *TemplateOutput `json:"output,omitempty"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (s *TemplateSpec) UnmarshalJSON(data []byte) error {
+	type templateSpec TemplateSpec
+	var fields struct {
+		templateSpec
+		RawParameters json.RawMessage `json:"parameters"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	*s = TemplateSpec(fields.templateSpec)
+	switch parameters := bytes.TrimSpace(fields.RawParameters); {
+	case len(parameters) == 0 || bytes.Equal(parameters, []byte("null")):
+	case parameters[0] == '{':
+		s.RawParameters = []json.RawMessage{parameters}
+	default:
+		if err := json.Unmarshal(parameters, &s.RawParameters); err != nil {
+			return fmt.Errorf("unmarshal template parameters: %w", err)
+		}
+	}
+	return nil
+}
+
+// Parameters decodes the parameter specs of the template.
+func (s *TemplateSpec) Parameters() ([]*TemplateParameters, error) {
+	result := make([]*TemplateParameters, 0, len(s.RawParameters))
+	for i, rawParameters := range s.RawParameters {
+		var parameters TemplateParameters
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("unmarshal template parameters %d: %w", i, err)
+		}
+		result = append(result, &parameters)
+	}
+	return result, nil
+}
+
+// Steps decodes the step specs of the template.
+func (s *TemplateSpec) Steps() ([]*TemplateStep, error) {
+	result := make([]*TemplateStep, 0, len(s.RawSteps))
+	for i, rawStep := range s.RawSteps {
+		var step TemplateStep
+		if err := json.Unmarshal(rawStep, &step); err != nil {
+			return nil, fmt.Errorf("unmarshal template step %d: %w", i, err)
+		}
+		result = append(result, &step)
+	}
+	return result, nil
+}
+
+// TemplateParameters is a page of template parameters, presented to the user as a step of a form.
+//
+// See: https://backstage.io/docs/features/software-templates/writing-templates#specparameters---formstep--formstep
+type TemplateParameters struct {
+	// Title of the page.
+	Title string `json:"title,omitempty"`
+
+	// Description of the page.
+	Description string `json:"description,omitempty"`
+
+	// Required parameter names.
+	Required []string `json:"required,omitempty"`
+
+	// Properties are the parameters of the page, by name.
+	Properties map[string]*TemplateParameterProperty `json:"properties,omitempty"`
+
+	// UI contains the page's ui:* options, by key, e.g. "ui:order".
+	UI map[string]json.RawMessage `json:"-"`
+
+	// Schema is the page's full JSON Schema, including ui:* options and any other keywords, e.g. dependencies.
+	Schema json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (p *TemplateParameters) UnmarshalJSON(data []byte) error {
+	type templateParameters TemplateParameters
+	var fields templateParameters
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	ui, err := unmarshalUIOptions(data)
+	if err != nil {
+		return err
+	}
+	*p = TemplateParameters(fields)
+	p.UI = ui
+	p.Schema = data
+	return nil
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (p TemplateParameters) MarshalJSON() ([]byte, error) {
+	type templateParameters TemplateParameters
+	object, err := parseJSONObject(p.Schema)
+	if err != nil {
+		return nil, err
+	}
+	// Keep the order of the properties from the original schema.
+	properties, err := parseJSONObject(object.get("properties"))
+	if err != nil {
+		return nil, err
+	}
+	if err := object.mergeStruct(templateParameters(p)); err != nil {
+		return nil, err
+	}
+	if len(p.Properties) > 0 {
+		for _, key := range slices.Clone(properties.keys) {
+			if _, ok := p.Properties[key]; !ok {
+				properties.delete(key)
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(p.Properties)) {
+			if err := properties.setValue(key, p.Properties[key]); err != nil {
+				return nil, err
+			}
+		}
+		if err := object.setValue("properties", properties); err != nil {
+			return nil, err
+		}
+	}
+	object.mergeUIOptions(p.UI)
+	return object.MarshalJSON()
+}
+
+// TemplateParameterProperty is the JSON Schema of a single template parameter.
+type TemplateParameterProperty struct {
+	// Title of the parameter.
+	Title string `json:"title,omitempty"`
+
+	// Description of the parameter.
+	Description string `json:"description,omitempty"`
+
+	// Type of the parameter, e.g. "string".
+	Type string `json:"type,omitempty"`
+
+	// Default value of the parameter.
+	Default json.RawMessage `json:"default,omitempty"`
+
+	// Enum contains the allowed values of the parameter.
+	Enum []json.RawMessage `json:"enum,omitempty"`
+
+	// UI contains the parameter's ui:* options, by key, e.g. "ui:field" or "ui:options".
+	UI map[string]json.RawMessage `json:"-"`
+
+	// Schema is the parameter's full JSON Schema, including ui:* options and any other keywords.
+	Schema json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (p *TemplateParameterProperty) UnmarshalJSON(data []byte) error {
+	type templateParameterProperty TemplateParameterProperty
+	var fields struct {
+		templateParameterProperty
+		// Type may also be a list of types.
+		Type json.RawMessage `json:"type,omitempty"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	ui, err := unmarshalUIOptions(data)
+	if err != nil {
+		return err
+	}
+	*p = TemplateParameterProperty(fields.templateParameterProperty)
+	if len(fields.Type) > 0 && fields.Type[0] == '"' {
+		if err := json.Unmarshal(fields.Type, &p.Type); err != nil {
+			return err
+		}
+	}
+	p.UI = ui
+	p.Schema = data
+	return nil
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (p TemplateParameterProperty) MarshalJSON() ([]byte, error) {
+	type templateParameterProperty TemplateParameterProperty
+	object, err := parseJSONObject(p.Schema)
+	if err != nil {
+		return nil, err
+	}
+	fields := struct {
+		templateParameterProperty
+		// Type may also be a list of types.
+		Type json.RawMessage `json:"type,omitempty"`
+	}{templateParameterProperty: templateParameterProperty(p)}
+	if p.Type != "" {
+		if fields.Type, err = json.Marshal(p.Type); err != nil {
+			return nil, err
+		}
+	} else if types := object.get("type"); len(types) > 0 && types[0] == '[' {
+		fields.Type = types
+	}
+	if err := object.mergeStruct(fields); err != nil {
+		return nil, err
+	}
+	object.mergeUIOptions(p.UI)
+	return object.MarshalJSON()
+}
+
+// TemplateStep is a step of a template, executed by the scaffolder.
+//
+// See: https://backstage.io/docs/features/software-templates/writing-templates#specsteps---actionstep
+type TemplateStep struct {
+	// ID of the step, used to reference the step's output.
+	ID string `json:"id,omitempty"`
+
+	// Name of the step.
+	Name string `json:"name,omitempty"`
+
+	// Action to execute, e.g. "fetch:template".
+	Action string `json:"action"`
+
+	// Input to the action.
+	Input json.RawMessage `json:"input,omitempty"`
+
+	// If is a boolean or an expression that determines if the step should be executed.
+	If json.RawMessage `json:"if,omitempty"`
+
+	// Each is a list or an expression to iterate over, executing the step once per item.
+	Each json.RawMessage `json:"each,omitempty"`
+}
+
+// TemplateOutput is the output of a template.
+//
+// See: https://backstage.io/docs/features/software-templates/writing-templates#outputs
+type TemplateOutput struct {
+	// Links to show to the user, e.g. to the created repository.
+	Links []*TemplateOutputLink `json:"links,omitempty"`
+
+	// Text to show to the user.
+	Text []*TemplateOutputText `json:"text,omitempty"`
+
+	// Values contains any other output values, by key, e.g. "remoteUrl" for v1beta2 templates.
+	Values map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (o *TemplateOutput) UnmarshalJSON(data []byte) error {
+	type templateOutput TemplateOutput
+	var fields templateOutput
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	delete(values, "links")
+	delete(values, "text")
+	*o = TemplateOutput(fields)
+	if len(values) > 0 {
+		o.Values = values
+	}
+	return nil
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (o TemplateOutput) MarshalJSON() ([]byte, error) {
+	type templateOutput TemplateOutput
+	object := newJSONObject()
+	if err := object.mergeStruct(templateOutput(o)); err != nil {
+		return nil, err
+	}
+	for _, key := range slices.Sorted(maps.Keys(o.Values)) {
+		object.set(key, o.Values[key])
+	}
+	return object.MarshalJSON()
+}
+
+// TemplateOutputLink is a link in the output of a template.
+type TemplateOutputLink struct {
+	// Title of the link.
+	Title string `json:"title,omitempty"`
+
+	// Icon of the link.
+	Icon string `json:"icon,omitempty"`
+
+	// URL of the link.
+	URL string `json:"url,omitempty"`
+
+	// EntityRef of an entity to link to, instead of a URL.
+	EntityRef string `json:"entityRef,omitempty"`
+}
+
+// TemplateOutputText is a text in the output of a template.
+type TemplateOutputText struct {
+	// Title of the text.
+	Title string `json:"title,omitempty"`
+
+	// Icon of the text.
+	Icon string `json:"icon,omitempty"`
+
+	// Content of the text, as Markdown.
+	Content string `json:"content,omitempty"`
+
+	// Default is true if the text should be shown by default.
+	Default bool `json:"default,omitempty"`
+}
+
+func unmarshalUIOptions(data []byte) (map[string]json.RawMessage, error) {
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	var result map[string]json.RawMessage
+	for key, value := range values {
+		if strings.HasPrefix(key, "ui:") {
+			if result == nil {
+				result = map[string]json.RawMessage{}
+			}
+			result[key] = value
+		}
+	}
+	return result, nil
+}
+
+// mergeUIOptions replaces the ui:* options of the object with the provided ui:* options.
+func (o *jsonObject) mergeUIOptions(ui map[string]json.RawMessage) {
+	for _, key := range slices.Clone(o.keys) {
+		if _, ok := ui[key]; !ok && strings.HasPrefix(key, "ui:") {
+			o.delete(key)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(ui)) {
+		o.set(key, ui[key])
+	}
 }
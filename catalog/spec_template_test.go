@@ -29,3 +29,114 @@ func TestEntity_TemplateSpec(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, expected, actual)
 }
+
+func TestTemplateSpec_v1beta3(t *testing.T) {
+	//nolint: lll
+	const template = `{"apiVersion":"scaffolder.backstage.io/v1beta3","kind":"Template","metadata":{"name":"v1beta3-demo"},"spec":{"owner":"team-platform","type":"service","parameters":{"title":"Provide some simple information","required":["component_id"],"properties":{"component_id":{"title":"Name","type":"string","ui:field":"EntityNamePicker","ui:autofocus":true},"tier":{"title":"Tier","type":"integer","enum":[1,2,3],"default":2}},"ui:order":["tier","component_id"]},"steps":[{"id":"fetch-base","name":"Fetch Base","action":"fetch:template","input":{"url":"./template","values":{"name":"${{ parameters.component_id }}"}}},{"id":"publish","name":"Publish","action":"publish:github","if":"${{ parameters.publish }}","each":["a","b"],"input":{"repoUrl":"${{ parameters.repoUrl }}"}}],"output":{"links":[{"title":"Repository","url":"${{ steps.publish.output.remoteUrl }}"},{"title":"Open in catalog","icon":"catalog","entityRef":"${{ steps.register.output.entityRef }}"}],"text":[{"title":"Next steps","content":"Read the **docs**.","default":true}]}}}`
+	var entity Entity
+	assert.NilError(t, json.Unmarshal([]byte(template), &entity))
+	assert.Equal(t, TemplateAPIVersionV1beta3, entity.APIVersion)
+	spec, err := entity.TemplateSpec()
+	assert.NilError(t, err)
+
+	t.Run("parameters", func(t *testing.T) {
+		parameters, err := spec.Parameters()
+		assert.NilError(t, err)
+		assert.Equal(t, 1, len(parameters))
+		page := parameters[0]
+		assert.Equal(t, "Provide some simple information", page.Title)
+		assert.DeepEqual(t, []string{"component_id"}, page.Required)
+		assert.DeepEqual(t, map[string]json.RawMessage{"ui:order": json.RawMessage(`["tier","component_id"]`)}, page.UI)
+		assert.Equal(t, 2, len(page.Properties))
+		componentID := page.Properties["component_id"]
+		assert.Equal(t, "Name", componentID.Title)
+		assert.Equal(t, "string", componentID.Type)
+		assert.DeepEqual(t, map[string]json.RawMessage{
+			"ui:field":     json.RawMessage(`"EntityNamePicker"`),
+			"ui:autofocus": json.RawMessage(`true`),
+		}, componentID.UI)
+		tier := page.Properties["tier"]
+		assert.Equal(t, "integer", tier.Type)
+		assert.DeepEqual(t, json.RawMessage(`2`), tier.Default)
+		assert.DeepEqual(t, []json.RawMessage{json.RawMessage(`1`), json.RawMessage(`2`), json.RawMessage(`3`)}, tier.Enum)
+	})
+
+	t.Run("steps", func(t *testing.T) {
+		steps, err := spec.Steps()
+		assert.NilError(t, err)
+		assert.Equal(t, 2, len(steps))
+		assert.Equal(t, "fetch-base", steps[0].ID)
+		assert.Equal(t, "Fetch Base", steps[0].Name)
+		assert.Equal(t, "fetch:template", steps[0].Action)
+		assert.Equal(t, `{"url":"./template","values":{"name":"${{ parameters.component_id }}"}}`, string(steps[0].Input))
+		assert.Assert(t, steps[0].If == nil)
+		assert.Equal(t, "publish:github", steps[1].Action)
+		assert.Equal(t, `"${{ parameters.publish }}"`, string(steps[1].If))
+		assert.Equal(t, `["a","b"]`, string(steps[1].Each))
+	})
+
+	t.Run("output", func(t *testing.T) {
+		expected := &TemplateOutput{
+			Links: []*TemplateOutputLink{
+				{Title: "Repository", URL: "${{ steps.publish.output.remoteUrl }}"},
+				{Title: "Open in catalog", Icon: "catalog", EntityRef: "${{ steps.register.output.entityRef }}"},
+			},
+			Text: []*TemplateOutputText{
+				{Title: "Next steps", Content: "Read the **docs**.", Default: true},
+			},
+		}
+		assert.DeepEqual(t, expected, spec.Output)
+	})
+}
+
+func TestTemplateSpec_v1beta2Output(t *testing.T) {
+	var spec TemplateSpec
+	assert.NilError(t, json.Unmarshal([]byte(`{
+  "type": "service",
+  "parameters": [],
+  "steps": [],
+  "output": {
+    "remoteUrl": "{{ steps.publish.output.remoteUrl }}",
+    "entityRef": "{{ steps.register.output.entityRef }}"
+  }
+}`), &spec))
+	assert.DeepEqual(t, &TemplateOutput{
+		Values: map[string]json.RawMessage{
+			"remoteUrl": json.RawMessage(`"{{ steps.publish.output.remoteUrl }}"`),
+			"entityRef": json.RawMessage(`"{{ steps.register.output.entityRef }}"`),
+		},
+	}, spec.Output)
+	data, err := json.Marshal(spec.Output)
+	assert.NilError(t, err)
+	assert.Equal(
+		t,
+		`{"entityRef":"{{ steps.register.output.entityRef }}","remoteUrl":"{{ steps.publish.output.remoteUrl }}"}`,
+		string(data),
+	)
+}
+
+func TestTemplateParameters_MarshalJSON(t *testing.T) {
+	//nolint: lll
+	const schema = `{"title":"Page","required":["name"],"properties":{"name":{"title":"Name","type":"string","ui:autofocus":true},"tags":{"type":["array","null"],"items":{"type":"string"}}},"dependencies":{"name":["tags"]},"ui:order":["name","tags"]}`
+	t.Run("round-trip", func(t *testing.T) {
+		var parameters TemplateParameters
+		assert.NilError(t, json.Unmarshal([]byte(schema), &parameters))
+		data, err := json.Marshal(parameters)
+		assert.NilError(t, err)
+		assert.Equal(t, schema, string(data))
+	})
+
+	t.Run("edit", func(t *testing.T) {
+		var parameters TemplateParameters
+		assert.NilError(t, json.Unmarshal([]byte(schema), &parameters))
+		parameters.Title = "Edited"
+		parameters.Properties["name"].Description = "Name of the component"
+		delete(parameters.Properties["name"].UI, "ui:autofocus")
+		parameters.Properties["name"].UI["ui:field"] = json.RawMessage(`"EntityNamePicker"`)
+		data, err := json.Marshal(parameters)
+		assert.NilError(t, err)
+		//nolint: lll
+		const expected = `{"title":"Edited","required":["name"],"properties":{"name":{"title":"Name","type":"string","description":"Name of the component","ui:field":"EntityNamePicker"},"tags":{"type":["array","null"],"items":{"type":"string"}}},"dependencies":{"name":["tags"]},"ui:order":["name","tags"]}`
+		assert.Equal(t, expected, string(data))
+	})
+}
@@ -0,0 +1,147 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TemplateValueError is a validation error for a template parameter value.
+type TemplateValueError struct {
+	// Field is the dot-separated path of the invalid value, e.g. "repoUrl" or "owners.0".
+	// Empty for errors that apply to the values as a whole.
+	Field string
+
+	// Message describes why the value is invalid.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *TemplateValueError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// ParametersSchema merges the parameter pages of the template into a single JSON Schema object.
+//
+// The properties, required properties and dependencies of all pages are merged, and any other keywords of the pages,
+// such as allOf, anyOf and oneOf, are combined with allOf. Annotations of the pages, such as titles, are dropped.
+func (s *TemplateSpec) ParametersSchema() (json.RawMessage, error) {
+	properties := newJSONObject()
+	dependencies := newJSONObject()
+	var required []string
+	var allOf []json.RawMessage
+	for i, rawParameters := range s.RawParameters {
+		page, err := parseJSONObject(rawParameters)
+		if err != nil {
+			return nil, fmt.Errorf("parse template parameters %d: %w", i, err)
+		}
+		for _, merged := range []struct {
+			key    string
+			object *jsonObject
+		}{
+			{key: "properties", object: properties},
+			{key: "dependencies", object: dependencies},
+		} {
+			pageObject, err := parseJSONObject(page.get(merged.key))
+			if err != nil {
+				return nil, fmt.Errorf("parse template parameters %d: %s: %w", i, merged.key, err)
+			}
+			for _, key := range pageObject.keys {
+				merged.object.set(key, pageObject.get(key))
+			}
+		}
+		if pageRequired := page.get("required"); len(pageRequired) > 0 {
+			var names []string
+			if err := json.Unmarshal(pageRequired, &names); err != nil {
+				return nil, fmt.Errorf("parse template parameters %d: required: %w", i, err)
+			}
+			for _, name := range names {
+				if !slices.Contains(required, name) {
+					required = append(required, name)
+				}
+			}
+		}
+		pageKeywords := newJSONObject()
+		for _, key := range page.keys {
+			switch {
+			case key == "properties", key == "required", key == "dependencies":
+			case strings.Contains(key, ":"), slices.Contains(jsonSchemaAnnotations, key):
+			default:
+				pageKeywords.set(key, page.get(key))
+			}
+		}
+		if len(pageKeywords.keys) > 0 {
+			composition, err := pageKeywords.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+			allOf = append(allOf, composition)
+		}
+	}
+	result := newJSONObject()
+	result.set("type", json.RawMessage(`"object"`))
+	if err := result.setValue("properties", properties); err != nil {
+		return nil, err
+	}
+	if len(required) > 0 {
+		if err := result.setValue("required", required); err != nil {
+			return nil, err
+		}
+	}
+	if len(dependencies.keys) > 0 {
+		if err := result.setValue("dependencies", dependencies); err != nil {
+			return nil, err
+		}
+	}
+	if len(allOf) > 0 {
+		if err := result.setValue("allOf", allOf); err != nil {
+			return nil, err
+		}
+	}
+	return result.MarshalJSON()
+}
+
+// ValidateValues validates template parameter values against the merged parameter pages of the template.
+//
+// Returns a validation error for each invalid value, or an error if the parameters or values can't be processed.
+// Required properties, types, enums, dependencies, and common string, number and array constraints are validated.
+// Parameters that use other JSON Schema keywords, such as $ref, not or if, or patterns that aren't supported by
+// [regexp], can't be processed.
+func (s *TemplateSpec) ValidateValues(values map[string]any) ([]*TemplateValueError, error) {
+	rawSchema, err := s.ParametersSchema()
+	if err != nil {
+		return nil, err
+	}
+	var schema jsonSchema
+	if err := json.Unmarshal(rawSchema, &schema); err != nil {
+		return nil, fmt.Errorf("validate template values: parse parameters: %w", err)
+	}
+	// Normalize values to their JSON representation.
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("validate template values: %w", err)
+	}
+	var normalizedValues map[string]any
+	if err := json.Unmarshal(data, &normalizedValues); err != nil {
+		return nil, fmt.Errorf("validate template values: %w", err)
+	}
+	if normalizedValues == nil {
+		normalizedValues = map[string]any{}
+	}
+	errs, err := schema.validate("", normalizedValues)
+	if err != nil {
+		return nil, fmt.Errorf("validate template values: %w", err)
+	}
+	result := make([]*TemplateValueError, 0, len(errs))
+	for _, err := range errs {
+		valueError := &TemplateValueError{Field: err.path, Message: err.message}
+		if !slices.ContainsFunc(result, func(e *TemplateValueError) bool { return *e == *valueError }) {
+			result = append(result, valueError)
+		}
+	}
+	return result, nil
+}
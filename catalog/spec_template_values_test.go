@@ -0,0 +1,190 @@
+package catalog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTemplateSpec_ParametersSchema(t *testing.T) {
+	var spec TemplateSpec
+	assert.NilError(t, json.Unmarshal([]byte(`{
+  "type": "service",
+  "parameters": [
+    {
+      "title": "Component",
+      "required": ["name"],
+      "properties": {"name": {"type": "string", "ui:autofocus": true}, "tier": {"type": "integer"}},
+      "dependencies": {"tier": ["name"]}
+    },
+    {
+      "title": "Repository",
+      "required": ["repoUrl", "name"],
+      "properties": {"repoUrl": {"type": "string", "ui:field": "RepoUrlPicker"}},
+      "oneOf": [{"required": ["repoUrl"]}]
+    }
+  ],
+  "steps": []
+}`), &spec))
+	schema, err := spec.ParametersSchema()
+	assert.NilError(t, err)
+	//nolint: lll
+	const expected = `{"type":"object","properties":{"name":{"type":"string","ui:autofocus":true},"tier":{"type":"integer"},"repoUrl":{"type":"string","ui:field":"RepoUrlPicker"}},"required":["name","repoUrl"],"dependencies":{"tier":["name"]},"allOf":[{"oneOf":[{"required":["repoUrl"]}]}]}`
+	assert.Equal(t, expected, string(schema))
+}
+
+func TestTemplateSpec_ValidateValues(t *testing.T) {
+	var spec TemplateSpec
+	assert.NilError(t, json.Unmarshal([]byte(`{
+  "type": "service",
+  "parameters": [
+    {
+      "title": "Component",
+      "required": ["name", "lifecycle"],
+      "properties": {
+        "name": {"type": "string", "pattern": "^[a-z-]+$", "maxLength": 20, "ui:autofocus": true},
+        "lifecycle": {"type": "string", "enum": ["experimental", "production"]},
+        "replicas": {"type": "integer", "minimum": 1},
+        "tags": {"type": "array", "items": {"type": "string"}, "uniqueItems": true},
+        "database": {"type": "boolean"}
+      },
+      "dependencies": {
+        "replicas": ["tags"],
+        "database": {
+          "oneOf": [
+            {"properties": {"database": {"const": false}}},
+            {"properties": {"database": {"const": true}, "engine": {"enum": ["postgres", "mysql"]}}, "required": ["engine"]}
+          ]
+        }
+      }
+    },
+    {
+      "title": "Repository",
+      "required": ["repoUrl"],
+      "properties": {"repoUrl": {"type": "string", "ui:field": "RepoUrlPicker"}}
+    }
+  ],
+  "steps": []
+}`), &spec))
+
+	for _, tt := range []struct {
+		name     string
+		values   map[string]any
+		expected []*TemplateValueError
+	}{
+		{
+			name: "valid",
+			values: map[string]any{
+				"name":      "payments",
+				"lifecycle": "production",
+				"replicas":  3,
+				"tags":      []string{"go", "grpc"},
+				"database":  true,
+				"engine":    "postgres",
+				"repoUrl":   "github.com?owner=einride&repo=payments",
+			},
+			expected: []*TemplateValueError{},
+		},
+		{
+			name:   "missing required",
+			values: map[string]any{"name": "payments"},
+			expected: []*TemplateValueError{
+				{Field: "lifecycle", Message: "is required"},
+				{Field: "repoUrl", Message: "is required"},
+			},
+		},
+		{
+			name: "invalid types and enums",
+			values: map[string]any{
+				"name":      "Payments Service",
+				"lifecycle": "deprecated",
+				"replicas":  1.5,
+				"tags":      []any{"go", 1, "go"},
+				"repoUrl":   "github.com?owner=einride&repo=payments",
+			},
+			expected: []*TemplateValueError{
+				{Field: "lifecycle", Message: `must be one of "experimental", "production"`},
+				{Field: "name", Message: `must match pattern "^[a-z-]+$"`},
+				{Field: "replicas", Message: "must be of type integer"},
+				{Field: "tags", Message: "must have unique items"},
+				{Field: "tags.1", Message: "must be of type string"},
+			},
+		},
+		{
+			name: "property dependency",
+			values: map[string]any{
+				"name":     "payments",
+				"replicas": 0,
+			},
+			expected: []*TemplateValueError{
+				{Field: "lifecycle", Message: "is required"},
+				{Field: "repoUrl", Message: "is required"},
+				{Field: "replicas", Message: "must be at least 1"},
+				{Field: "tags", Message: "is required when replicas is set"},
+			},
+		},
+		{
+			name: "schema dependency",
+			values: map[string]any{
+				"name":      "payments",
+				"lifecycle": "production",
+				"database":  true,
+				"repoUrl":   "github.com?owner=einride&repo=payments",
+			},
+			expected: []*TemplateValueError{
+				{Field: "engine", Message: "is required"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := spec.ValidateValues(tt.values)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.expected, actual)
+		})
+	}
+
+	t.Run("unsupported parameters", func(t *testing.T) {
+		for _, tt := range []struct {
+			name       string
+			parameters string
+			expected   string
+		}{
+			{
+				name:       "ref",
+				parameters: `{"properties": {"name": {"$ref": "#/definitions/name"}}}`,
+				expected:   `unsupported keyword "$ref"`,
+			},
+			{
+				name:       "not",
+				parameters: `{"properties": {"name": {"type": "string", "not": {"const": "admin"}}}}`,
+				expected:   `unsupported keyword "not"`,
+			},
+			{
+				name:       "dependency schema",
+				parameters: `{"dependencies": {"name": {"if": {"required": ["name"]}}}}`,
+				expected:   `unsupported keyword "if"`,
+			},
+			{
+				name:       "page keyword",
+				parameters: `{"properties": {"name": {"type": "string"}}, "additionalProperties": false}`,
+				expected:   `unsupported keyword "additionalProperties"`,
+			},
+			{
+				name:       "lookahead pattern",
+				parameters: `{"properties": {"name": {"type": "string", "pattern": "^(?!foo).*$"}}}`,
+				expected:   `unsupported pattern "^(?!foo).*$"`,
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				spec := TemplateSpec{RawParameters: []json.RawMessage{json.RawMessage(tt.parameters)}}
+				_, err := spec.ValidateValues(map[string]any{"name": "foo"})
+				assert.ErrorContains(t, err, tt.expected)
+			})
+		}
+	})
+
+	t.Run("error message", func(t *testing.T) {
+		assert.Equal(t, "name: is required", (&TemplateValueError{Field: "name", Message: "is required"}).Error())
+	})
+}
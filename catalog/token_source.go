@@ -0,0 +1,95 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource provides bearer tokens for authenticating requests.
+//
+// The token source is called for every request, and implementations are responsible for any caching.
+type TokenSource interface {
+	// Token returns the token to use for a request.
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenInvalidator is an optional interface for a [TokenSource] that caches tokens.
+//
+// When the server rejects a token as unauthorized, the token is invalidated and the request is retried once with a
+// new token from the token source.
+type TokenInvalidator interface {
+	// InvalidateToken discards any cached token, so that the next call to Token returns a fresh token.
+	InvalidateToken()
+}
+
+// TokenSourceFunc is a [TokenSource] implemented by a callback.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token implements [TokenSource].
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticTokenSource returns a [TokenSource] that always returns the same token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// FileTokenSource returns a [TokenSource] that reads a token from a file.
+//
+// The file is re-read when its modification time or size changes, and when the token is invalidated.
+// Leading and trailing whitespace is trimmed from the file contents.
+func FileTokenSource(filename string) TokenSource {
+	return &fileTokenSource{filename: filename}
+}
+
+type fileTokenSource struct {
+	filename string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+var _ TokenInvalidator = &fileTokenSource{}
+
+func (f *fileTokenSource) Token(context.Context) (string, error) {
+	info, err := os.Stat(f.filename)
+	if err != nil {
+		return "", fmt.Errorf("read token file: %w", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.token != "" && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.token, nil
+	}
+	data, err := os.ReadFile(f.filename)
+	if err != nil {
+		return "", fmt.Errorf("read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("read token file: %s is empty", f.filename)
+	}
+	f.token = token
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	return f.token, nil
+}
+
+func (f *fileTokenSource) InvalidateToken() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.token = ""
+}
@@ -0,0 +1,76 @@
+package catalog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestStaticTokenSource(t *testing.T) {
+	token, err := StaticTokenSource("foo").Token(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, "foo", token)
+}
+
+func TestTokenSourceFunc(t *testing.T) {
+	tokenSource := TokenSourceFunc(func(context.Context) (string, error) {
+		return "foo", nil
+	})
+	token, err := tokenSource.Token(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, "foo", token)
+}
+
+func TestFileTokenSource(t *testing.T) {
+	ctx := context.Background()
+	filename := filepath.Join(t.TempDir(), "token")
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := FileTokenSource(filename).Token(ctx)
+		assert.ErrorContains(t, err, "read token file")
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		assert.NilError(t, os.WriteFile(filename, []byte("\n"), 0o600))
+		_, err := FileTokenSource(filename).Token(ctx)
+		assert.ErrorContains(t, err, "is empty")
+	})
+
+	t.Run("re-read on change", func(t *testing.T) {
+		assert.NilError(t, os.WriteFile(filename, []byte("foo\n"), 0o600))
+		tokenSource := FileTokenSource(filename)
+		token, err := tokenSource.Token(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, "foo", token)
+		assert.NilError(t, os.WriteFile(filename, []byte("barbaz\n"), 0o600))
+		modTime := time.Now().Add(time.Second)
+		assert.NilError(t, os.Chtimes(filename, modTime, modTime))
+		token, err = tokenSource.Token(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, "barbaz", token)
+	})
+
+	t.Run("re-read on invalidate", func(t *testing.T) {
+		assert.NilError(t, os.WriteFile(filename, []byte("foo"), 0o600))
+		modTime := time.Now()
+		assert.NilError(t, os.Chtimes(filename, modTime, modTime))
+		tokenSource := FileTokenSource(filename)
+		token, err := tokenSource.Token(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, "foo", token)
+		// Same size and modification time, so only detected after invalidation.
+		assert.NilError(t, os.WriteFile(filename, []byte("bar"), 0o600))
+		assert.NilError(t, os.Chtimes(filename, modTime, modTime))
+		token, err = tokenSource.Token(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, "foo", token)
+		tokenSource.(TokenInvalidator).InvalidateToken()
+		token, err = tokenSource.Token(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, "bar", token)
+	})
+}
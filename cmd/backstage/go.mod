@@ -1,8 +1,8 @@
 module go.einride.tech/backstage/cmd/backstage
 
-go 1.22
+go 1.23
 
-toolchain go1.22.5
+toolchain go1.23.4
 
 require (
 	github.com/adrg/xdg v0.5.3
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,12 +11,15 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/adrg/xdg"
 	"github.com/santhosh-tekuri/jsonschema"
 	"github.com/spf13/cobra"
 	"go.einride.tech/backstage/catalog"
 	"go.einride.tech/backstage/cmd/backstage/internal/schema"
+	"go.einride.tech/backstage/scaffolder"
+	"go.einride.tech/backstage/search"
 	"gopkg.in/yaml.v3"
 )
 
@@ -29,6 +33,39 @@ func main() {
 const authConfigFile = "backstage-go/auth.json"
 
 func newCatalogClient() (*catalog.Client, error) {
+	authFileContent, err := readAuthFile()
+	if err != nil {
+		return nil, err
+	}
+	return catalog.NewClient(
+		catalog.WithBaseURL(authFileContent.BaseURL),
+		catalog.WithToken(authFileContent.Token),
+	), nil
+}
+
+func newSearchClient() (*search.Client, error) {
+	authFileContent, err := readAuthFile()
+	if err != nil {
+		return nil, err
+	}
+	return search.NewClient(
+		catalog.WithBaseURL(authFileContent.BaseURL),
+		catalog.WithToken(authFileContent.Token),
+	), nil
+}
+
+func newScaffolderClient() (*scaffolder.Client, error) {
+	authFileContent, err := readAuthFile()
+	if err != nil {
+		return nil, err
+	}
+	return scaffolder.NewClient(
+		catalog.WithBaseURL(authFileContent.BaseURL),
+		catalog.WithToken(authFileContent.Token),
+	), nil
+}
+
+func readAuthFile() (*authFile, error) {
 	authFilepath, err := xdg.ConfigFile(authConfigFile)
 	if err != nil {
 		return nil, err
@@ -44,10 +81,7 @@ func newCatalogClient() (*catalog.Client, error) {
 	if err := json.Unmarshal(data, &authFileContent); err != nil {
 		return nil, err
 	}
-	return catalog.NewClient(
-		catalog.WithBaseURL(authFileContent.BaseURL),
-		catalog.WithToken(authFileContent.Token),
-	), nil
+	return &authFileContent, nil
 }
 
 func newBackstageCommand() *cobra.Command {
@@ -56,6 +90,8 @@ func newBackstageCommand() *cobra.Command {
 	cmd.Short = "Backstage CLI"
 	cmd.AddCommand(newAuthCommand())
 	cmd.AddCommand(newCatalogCommand())
+	cmd.AddCommand(newScaffolderCommand())
+	cmd.AddCommand(newSearchCommand())
 	return cmd
 }
 
@@ -107,6 +143,7 @@ func newCatalogCommand() *cobra.Command {
 	cmd.Use = "catalog"
 	cmd.Short = "Work with the Backstage catalog"
 	cmd.AddCommand(newEntitiesCommand())
+	cmd.AddCommand(newLocationsCommand())
 	return cmd
 }
 
@@ -116,10 +153,14 @@ func newEntitiesCommand() *cobra.Command {
 	cmd.Short = "Work with entities in the Backstage catalog"
 	cmd.AddCommand(newEntitiesValidateCommand())
 	cmd.AddCommand(newEntitiesListCommand())
+	cmd.AddCommand(newEntitiesQueryCommand())
+	cmd.AddCommand(newEntitiesFacetsCommand())
 	cmd.AddCommand(newEntitiesGetByUIDCommand())
 	cmd.AddCommand(newEntitiesGetByNameCommand())
+	cmd.AddCommand(newEntitiesAncestryCommand())
 	cmd.AddCommand(newEntitiesDeleteByUIDCommand())
 	cmd.AddCommand(newEntitiesBatchGetByRefsCommand())
+	cmd.AddCommand(newEntitiesRefreshCommand())
 	return cmd
 }
 
@@ -134,13 +175,59 @@ func newEntitiesListCommand() *cobra.Command {
 		if err != nil {
 			return err
 		}
-		var nextPageToken string
+		for entity, err := range client.ListEntitiesAll(cmd.Context(), &catalog.ListEntitiesAllRequest{
+			Filters:  *filters,
+			Fields:   *fields,
+			Prefetch: true,
+		}) {
+			if err != nil {
+				return err
+			}
+			printRawJSON(cmd, entity.Raw)
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newEntitiesQueryCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "query"
+	cmd.Short = "Query entities in the catalog"
+	filters := cmd.Flags().StringArray("filter", nil, "select only a subset of all entities")
+	fields := cmd.Flags().StringSlice("fields", nil, "select only parts of each entity")
+	orderFields := cmd.Flags().StringArray("order-field", nil, "order by a field, on the format <field>,<asc|desc>")
+	fullTextFilterTerm := cmd.Flags().String("full-text-filter-term", "", "select only entities matching a search term")
+	fullTextFilterFields := cmd.Flags().StringSlice("full-text-filter-fields", nil, "fields to match the term against")
+	limit := cmd.Flags().Int64("limit", 0, "maximum number of entities to return (all entities if unset)")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		orderings := make([]catalog.Ordering, 0, len(*orderFields))
+		for _, orderField := range *orderFields {
+			ordering, err := catalog.ParseOrdering(orderField)
+			if err != nil {
+				return err
+			}
+			orderings = append(orderings, ordering)
+		}
+		var count int64
+		var cursor string
 		for {
-			response, err := client.ListEntities(cmd.Context(), &catalog.ListEntitiesRequest{
-				Filters: *filters,
-				Fields:  *fields,
-				Limit:   100,
-				After:   nextPageToken,
+			pageSize := int64(100)
+			if *limit > 0 {
+				pageSize = min(pageSize, *limit-count)
+			}
+			response, err := client.QueryEntities(cmd.Context(), &catalog.QueryEntitiesRequest{
+				Filters:              *filters,
+				Fields:               *fields,
+				OrderFields:          orderings,
+				FullTextFilterTerm:   *fullTextFilterTerm,
+				FullTextFilterFields: *fullTextFilterFields,
+				Limit:                pageSize,
+				Cursor:               cursor,
 			})
 			if err != nil {
 				return err
@@ -148,8 +235,9 @@ func newEntitiesListCommand() *cobra.Command {
 			for _, entity := range response.Entities {
 				printRawJSON(cmd, entity.Raw)
 			}
-			nextPageToken = response.NextPageToken
-			if nextPageToken == "" {
+			count += int64(len(response.Entities))
+			cursor = response.NextCursor
+			if cursor == "" || (*limit > 0 && count >= *limit) {
 				break
 			}
 		}
@@ -158,6 +246,30 @@ func newEntitiesListCommand() *cobra.Command {
 	return cmd
 }
 
+func newEntitiesFacetsCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "facets"
+	cmd.Short = "Get value counts of entity fields in the catalog"
+	facets := cmd.Flags().StringArray("facet", nil, "entity field to count values of")
+	_ = cmd.MarkFlagRequired("facet")
+	filters := cmd.Flags().StringArray("filter", nil, "select only a subset of all entities")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		response, err := client.GetEntityFacets(cmd.Context(), &catalog.GetEntityFacetsRequest{
+			Facets:  *facets,
+			Filters: *filters,
+		})
+		if err != nil {
+			return err
+		}
+		return printJSON(cmd, response.Facets)
+	}
+	return cmd
+}
+
 func newEntitiesGetByNameCommand() *cobra.Command {
 	cmd := newCommand()
 	cmd.Use = "get-by-name"
@@ -186,6 +298,55 @@ func newEntitiesGetByNameCommand() *cobra.Command {
 	return cmd
 }
 
+func newEntitiesAncestryCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "ancestry"
+	cmd.Short = "Print the ancestry of an entity as a tree"
+	kind := cmd.Flags().String("kind", "", "kind of the entity")
+	_ = cmd.MarkFlagRequired("kind")
+	namespace := cmd.Flags().String("namespace", "default", "namespace of the entity")
+	name := cmd.Flags().String("name", "", "name of the entity")
+	_ = cmd.MarkFlagRequired("name")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		response, err := client.GetEntityAncestryByName(cmd.Context(), &catalog.GetEntityAncestryByNameRequest{
+			Kind:      *kind,
+			Namespace: *namespace,
+			Name:      *name,
+		})
+		if err != nil {
+			return err
+		}
+		parentEntityRefs := make(map[string][]string, len(response.Items))
+		for _, item := range response.Items {
+			parentEntityRefs[entityRefKey(item.Entity)] = item.ParentEntityRefs
+		}
+		visited := map[string]bool{}
+		var printTree func(entityRef string, depth int)
+		printTree = func(entityRef string, depth int) {
+			cmd.Printf("%s%s\n", strings.Repeat("  ", depth), entityRef)
+			key := strings.ToLower(entityRef)
+			if visited[key] {
+				return
+			}
+			visited[key] = true
+			for _, parentEntityRef := range parentEntityRefs[key] {
+				printTree(parentEntityRef, depth+1)
+			}
+		}
+		printTree(response.RootEntityRef, 0)
+		return nil
+	}
+	return cmd
+}
+
+func entityRefKey(entity *catalog.Entity) string {
+	return strings.ToLower(entity.Ref().String())
+}
+
 func newEntitiesGetByUIDCommand() *cobra.Command {
 	cmd := newCommand()
 	cmd.Use = "get-by-uid"
@@ -258,17 +419,230 @@ func newEntitiesBatchGetByRefsCommand() *cobra.Command {
 	return cmd
 }
 
+func newLocationsCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "locations"
+	cmd.Short = "Work with locations in the Backstage catalog"
+	cmd.AddCommand(newLocationsListCommand())
+	cmd.AddCommand(newLocationsGetByIDCommand())
+	cmd.AddCommand(newLocationsGetByEntityCommand())
+	cmd.AddCommand(newLocationsCreateCommand())
+	cmd.AddCommand(newLocationsDeleteByIDCommand())
+	cmd.AddCommand(newLocationsAnalyzeCommand())
+	return cmd
+}
+
+func newLocationsListCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "list"
+	cmd.Short = "List locations in the catalog"
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		response, err := client.ListLocations(cmd.Context(), &catalog.ListLocationsRequest{})
+		if err != nil {
+			return err
+		}
+		for _, location := range response.Locations {
+			if err := printJSON(cmd, location); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newLocationsGetByIDCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "get-by-id"
+	cmd.Short = "Get a location by its ID"
+	id := cmd.Flags().String("id", "", "ID of the location to get")
+	_ = cmd.MarkFlagRequired("id")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		location, err := client.GetLocationByID(cmd.Context(), &catalog.GetLocationByIDRequest{
+			ID: *id,
+		})
+		if err != nil {
+			return err
+		}
+		return printJSON(cmd, location)
+	}
+	return cmd
+}
+
+func newLocationsGetByEntityCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "get-by-entity"
+	cmd.Short = "Get the location of an entity by the entity's kind, namespace and name"
+	kind := cmd.Flags().String("kind", "", "kind of the entity")
+	_ = cmd.MarkFlagRequired("kind")
+	namespace := cmd.Flags().String("namespace", "default", "namespace of the entity")
+	name := cmd.Flags().String("name", "", "name of the entity")
+	_ = cmd.MarkFlagRequired("name")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		location, err := client.GetLocationByEntity(cmd.Context(), &catalog.GetLocationByEntityRequest{
+			Kind:      *kind,
+			Namespace: *namespace,
+			Name:      *name,
+		})
+		if err != nil {
+			return err
+		}
+		return printJSON(cmd, location)
+	}
+	return cmd
+}
+
+func newLocationsCreateCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "create"
+	cmd.Short = "Register a new location in the catalog"
+	locationType := cmd.Flags().String("type", "url", "type of the location")
+	target := cmd.Flags().String("target", "", "target of the location")
+	_ = cmd.MarkFlagRequired("target")
+	dryRun := cmd.Flags().Bool("dry-run", false, "validate the location without registering it")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		response, err := client.CreateLocation(cmd.Context(), &catalog.CreateLocationRequest{
+			Type:   *locationType,
+			Target: *target,
+			DryRun: *dryRun,
+		})
+		if err != nil {
+			return err
+		}
+		if err := printJSON(cmd, response.Location); err != nil {
+			return err
+		}
+		for _, entity := range response.Entities {
+			printRawJSON(cmd, entity.Raw)
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newLocationsDeleteByIDCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "delete"
+	cmd.Short = "Delete a location by its ID"
+	id := cmd.Flags().String("id", "", "ID of the location to delete")
+	_ = cmd.MarkFlagRequired("id")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		return client.DeleteLocationByID(cmd.Context(), &catalog.DeleteLocationByIDRequest{
+			ID: *id,
+		})
+	}
+	return cmd
+}
+
+func newEntitiesRefreshCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "refresh"
+	cmd.Short = "Refresh an entity in the catalog"
+	entityRef := cmd.Flags().String("entity-ref", "", "ref of the entity to refresh")
+	_ = cmd.MarkFlagRequired("entity-ref")
+	wait := cmd.Flags().Bool("wait", false, "wait until the refreshed entity is visible in the catalog")
+	timeout := cmd.Flags().Duration("timeout", time.Minute, "maximum time to wait for the refresh")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		if !*wait {
+			return client.RefreshEntity(cmd.Context(), &catalog.RefreshEntityRequest{
+				EntityRef: *entityRef,
+			})
+		}
+		ctx, cancel := context.WithTimeout(cmd.Context(), *timeout)
+		defer cancel()
+		entity, err := client.RefreshEntityAndWait(ctx, &catalog.RefreshEntityAndWaitRequest{
+			EntityRef: *entityRef,
+		})
+		if err != nil {
+			return err
+		}
+		printRawJSON(cmd, entity.Raw)
+		return nil
+	}
+	return cmd
+}
+
+func newLocationsAnalyzeCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "analyze"
+	cmd.Short = "Preview what the catalog would import from a location"
+	locationURL := cmd.Flags().String("url", "", "URL of the location to analyze, e.g. a repository URL")
+	_ = cmd.MarkFlagRequired("url")
+	catalogFilename := cmd.Flags().String("catalog-filename", "", "name of the entity file to look for")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newCatalogClient()
+		if err != nil {
+			return err
+		}
+		response, err := client.AnalyzeLocation(cmd.Context(), &catalog.AnalyzeLocationRequest{
+			Location:        &catalog.LocationSpec{Type: "url", Target: *locationURL},
+			CatalogFilename: *catalogFilename,
+		})
+		if err != nil {
+			return err
+		}
+		for _, existing := range response.ExistingEntityFiles {
+			if existing.IsRegistered {
+				cmd.Printf("existing entity file (registered): %s\n", existing.Location.Target)
+			} else {
+				cmd.Printf("existing entity file (not registered): %s\n", existing.Location.Target)
+			}
+			printRawJSON(cmd, existing.Entity.Raw)
+		}
+		for _, generate := range response.GenerateEntities {
+			cmd.Println("generated entity:")
+			printRawJSON(cmd, generate.Entity.Raw)
+			for _, field := range generate.Fields {
+				cmd.Printf("%s (%s): %s\n", field.Field, field.State, field.Value)
+			}
+		}
+		return nil
+	}
+	return cmd
+}
+
 func newEntitiesValidateCommand() *cobra.Command {
 	cmd := newCommand()
 	cmd.Use = "validate [FILES]"
 	cmd.Short = "Validate entity files"
 	cmd.Args = cobra.MinimumNArgs(1)
+	remote := cmd.Flags().Bool("remote", false, "also validate entities against the Backstage instance")
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		compiler, err := newEntitySchemaCompiler()
 		if err != nil {
 			return err
 		}
-		var count int
+		var client *catalog.Client
+		if *remote {
+			if client, err = newCatalogClient(); err != nil {
+				return err
+			}
+		}
+		var count, invalidCount int
 		for _, arg := range args {
 			if err := filepath.WalkDir(arg, func(path string, d fs.DirEntry, _ error) error {
 				if d.IsDir() {
@@ -282,6 +656,7 @@ func newEntitiesValidateCommand() *cobra.Command {
 					if err != nil {
 						return err
 					}
+					var validationErrors []*catalog.SerializedError
 					decoder := yaml.NewDecoder(bytes.NewReader(data))
 					for {
 						var entity map[string]any
@@ -302,20 +677,282 @@ func newEntitiesValidateCommand() *cobra.Command {
 						if err := entitySchema.ValidateInterface(entity); err != nil {
 							return err
 						}
+						if client != nil {
+							response, err := validateEntityRemote(cmd.Context(), client, path, entity)
+							if err != nil {
+								return err
+							}
+							validationErrors = append(validationErrors, response.Errors...)
+						}
 						count++
 					}
+					if client != nil {
+						if len(validationErrors) > 0 {
+							invalidCount++
+						}
+						printValidationResult(cmd, path, validationErrors)
+					}
 				}
 				return nil
 			}); err != nil {
 				return err
 			}
 		}
+		if invalidCount > 0 {
+			return fmt.Errorf("%d invalid catalog entity files", invalidCount)
+		}
 		cmd.Printf("%d valid catalog entities", count)
 		return nil
 	}
 	return cmd
 }
 
+func validateEntityRemote(
+	ctx context.Context,
+	client *catalog.Client,
+	path string,
+	entity map[string]any,
+) (*catalog.ValidateEntityResponse, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	entityData, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	return client.ValidateEntity(ctx, &catalog.ValidateEntityRequest{
+		Entity:   entityData,
+		Location: "file:" + absPath,
+	})
+}
+
+func printValidationResult(cmd *cobra.Command, path string, validationErrors []*catalog.SerializedError) {
+	if len(validationErrors) == 0 {
+		cmd.Printf("%s: valid\n", path)
+		return
+	}
+	for _, validationError := range validationErrors {
+		cmd.Printf("%s: %v\n", path, validationError)
+	}
+}
+
+func newScaffolderCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "scaffolder"
+	cmd.Short = "Work with the Backstage scaffolder"
+	cmd.AddCommand(newScaffolderValidateValuesCommand())
+	cmd.AddCommand(newScaffolderDryRunCommand())
+	return cmd
+}
+
+func newScaffolderValidateValuesCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "validate-values"
+	cmd.Short = "Validate input values against the parameters of a template"
+	templateFile := cmd.Flags().String("template", "", "template entity file")
+	_ = cmd.MarkFlagRequired("template")
+	valuesFile := cmd.Flags().String("values", "", "input values file")
+	_ = cmd.MarkFlagRequired("values")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		template, err := readTemplateFile(*templateFile)
+		if err != nil {
+			return err
+		}
+		templateSpec, err := template.TemplateSpec()
+		if err != nil {
+			return err
+		}
+		values, err := readValuesFile(*valuesFile)
+		if err != nil {
+			return err
+		}
+		valueErrors, err := templateSpec.ValidateValues(values)
+		if err != nil {
+			return err
+		}
+		for _, valueError := range valueErrors {
+			cmd.Printf("%s: %v\n", *valuesFile, valueError)
+		}
+		if len(valueErrors) > 0 {
+			return fmt.Errorf("%d invalid template values", len(valueErrors))
+		}
+		cmd.Printf("%s: valid\n", *valuesFile)
+		return nil
+	}
+	return cmd
+}
+
+func newScaffolderDryRunCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "dry-run"
+	cmd.Short = "Execute a local template without side effects"
+	templateFile := cmd.Flags().String("template", "", "template entity file")
+	_ = cmd.MarkFlagRequired("template")
+	valuesFile := cmd.Flags().String("values", "", "input values file")
+	dir := cmd.Flags().String("dir", "", "directory with the template's files (default: the template file's directory)")
+	output := cmd.Flags().String("output", "dry-run-output", "directory to write the resulting files to")
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		client, err := newScaffolderClient()
+		if err != nil {
+			return err
+		}
+		template, err := readTemplateFile(*templateFile)
+		if err != nil {
+			return err
+		}
+		var values map[string]any
+		if *valuesFile != "" {
+			if values, err = readValuesFile(*valuesFile); err != nil {
+				return err
+			}
+		}
+		if *dir == "" {
+			*dir = filepath.Dir(*templateFile)
+		}
+		files, err := scaffolder.ReadFiles(os.DirFS(*dir))
+		if err != nil {
+			return err
+		}
+		response, err := client.DryRun(cmd.Context(), &scaffolder.DryRunRequest{
+			Template:          template,
+			Values:            values,
+			DirectoryContents: files,
+		})
+		if err != nil {
+			return err
+		}
+		for _, entry := range response.Log {
+			if entry.Body.StepID != "" {
+				cmd.Printf("[%s] %s\n", entry.Body.StepID, entry.Body.Message)
+			} else {
+				cmd.Println(entry.Body.Message)
+			}
+		}
+		for _, file := range response.DirectoryContents {
+			if err := writeDryRunFile(*output, file); err != nil {
+				return err
+			}
+		}
+		cmd.Printf("\nWrote %d files to %s\n", len(response.DirectoryContents), *output)
+		if len(response.Output) > 0 {
+			cmd.Println("\nOutput:")
+			printRawJSON(cmd, response.Output)
+		}
+		return nil
+	}
+	return cmd
+}
+
+func writeDryRunFile(dir string, file *scaffolder.File) error {
+	if !filepath.IsLocal(filepath.FromSlash(file.Path)) {
+		return fmt.Errorf("write dry-run file: invalid path: %s", file.Path)
+	}
+	path := filepath.Join(dir, filepath.FromSlash(file.Path))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	var perm os.FileMode = 0o644
+	if file.Executable {
+		perm = 0o755
+	}
+	return os.WriteFile(path, file.Content, perm)
+}
+
+func readTemplateFile(path string) (*catalog.Entity, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var template map[string]any
+	if err := yaml.Unmarshal(data, &template); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	templateData, err := json.Marshal(template)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var entity catalog.Entity
+	if err := json.Unmarshal(templateData, &entity); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &entity, nil
+}
+
+func readValuesFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]any
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return values, nil
+}
+
+func newSearchCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "search"
+	cmd.Short = "Search Backstage"
+	cmd.AddCommand(newSearchQueryCommand())
+	return cmd
+}
+
+func newSearchQueryCommand() *cobra.Command {
+	cmd := newCommand()
+	cmd.Use = "query TERM"
+	cmd.Short = "Search for documents"
+	cmd.Args = cobra.ExactArgs(1)
+	types := cmd.Flags().StringSlice("type", nil, "types of documents to search, e.g. software-catalog")
+	filters := cmd.Flags().StringArray("filter", nil, "filter on a document field, on the format <field>=<value>")
+	pageLimit := cmd.Flags().Int64("page-limit", 0, "maximum number of results per page")
+	pageCursor := cmd.Flags().String("page-cursor", "", "cursor of the page to get")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		client, err := newSearchClient()
+		if err != nil {
+			return err
+		}
+		filterValues := map[string][]string{}
+		for _, filter := range *filters {
+			key, value, ok := strings.Cut(filter, "=")
+			if !ok {
+				return fmt.Errorf("invalid filter: %s", filter)
+			}
+			filterValues[key] = append(filterValues[key], value)
+		}
+		response, err := client.Query(cmd.Context(), &search.QueryRequest{
+			Term:       args[0],
+			Types:      *types,
+			Filters:    filterValues,
+			PageLimit:  *pageLimit,
+			PageCursor: *pageCursor,
+		})
+		if err != nil {
+			return err
+		}
+		for _, result := range response.Results {
+			cmd.Printf("%s [%s]\n", result.Document.Title, result.Type)
+			cmd.Printf("  %s\n", result.Document.Location)
+			if text := strings.Join(strings.Fields(result.Document.Text), " "); text != "" {
+				const maxTextLength = 200
+				if runes := []rune(text); len(runes) > maxTextLength {
+					text = string(runes[:maxTextLength]) + "..."
+				}
+				cmd.Printf("  %s\n", text)
+			}
+		}
+		if response.PreviousPageCursor != "" {
+			cmd.Printf("\nPrevious page: --page-cursor %s\n", response.PreviousPageCursor)
+		}
+		if response.NextPageCursor != "" {
+			cmd.Printf("\nNext page: --page-cursor %s\n", response.NextPageCursor)
+		}
+		return nil
+	}
+	return cmd
+}
+
 func newEntitySchemaCompiler() (*jsonschema.Compiler, error) {
 	files, err := fs.ReadDir(schema.FS(), ".")
 	if err != nil {
@@ -341,6 +978,15 @@ func newEntitySchemaCompiler() (*jsonschema.Compiler, error) {
 	return result, nil
 }
 
+func printJSON(cmd *cobra.Command, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	printRawJSON(cmd, data)
+	return nil
+}
+
 func printRawJSON(cmd *cobra.Command, raw json.RawMessage) {
 	var indented bytes.Buffer
 	indented.Grow(len(raw) * 2)
@@ -0,0 +1,34 @@
+// Package backstagehttptest provides test servers for the clients of the Backstage APIs.
+package backstagehttptest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.einride.tech/backstage/internal/backstagehttp"
+)
+
+// Token is the bearer token sent by clients configured with the options of [NewServer].
+const Token = "HELLO_WORLD"
+
+// NewServer starts a test server with the provided handler, which is closed when the test completes.
+//
+// Returns the options of a client that sends requests to the server, authenticated with [Token].
+func NewServer(t testing.TB, handler func(http.ResponseWriter, *http.Request)) []backstagehttp.Option {
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(server.Close)
+	return []backstagehttp.Option{
+		func(config *backstagehttp.Config) {
+			config.BaseURL = server.URL
+			config.TokenSource = tokenSource{}
+		},
+	}
+}
+
+type tokenSource struct{}
+
+func (tokenSource) Token(context.Context) (string, error) {
+	return Token, nil
+}
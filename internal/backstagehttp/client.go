@@ -0,0 +1,239 @@
+package backstagehttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+// TokenSource provides bearer tokens for authenticating requests.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenInvalidator is an optional interface for a [TokenSource] that caches tokens.
+type TokenInvalidator interface {
+	InvalidateToken()
+}
+
+// Option configures a [Client].
+type Option func(*Config)
+
+// Config configures a [Client].
+type Config struct {
+	// TokenSource provides bearer tokens for authentication, if any.
+	TokenSource TokenSource
+	// BaseURL is the backend base URL.
+	BaseURL string
+	// HTTPClient is the HTTP client to base the client's HTTP client on. Copied and never modified.
+	HTTPClient *http.Client
+	// Transport takes precedence over the transport of the HTTP client.
+	Transport http.RoundTripper
+	// RetryPolicy for failed requests.
+	RetryPolicy RetryPolicy
+}
+
+// Client sends requests to a Backstage API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a new [Client] with authentication and retries added on top of the configured transport.
+func NewClient(options ...Option) *Client {
+	var config Config
+	for _, option := range options {
+		option(&config)
+	}
+	httpClient := &http.Client{}
+	if config.HTTPClient != nil {
+		*httpClient = *config.HTTPClient
+	}
+	httpClient.Transport = config.newTransport(httpClient.Transport)
+	return &Client{baseURL: config.BaseURL, httpClient: httpClient}
+}
+
+// HTTPClient returns the HTTP client used for requests.
+func (c *Client) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// newTransport wraps the transport of the config, or the provided default transport, with authentication and retries.
+func (c *Config) newTransport(defaultTransport http.RoundTripper) http.RoundTripper {
+	transport := defaultTransport
+	if c.Transport != nil {
+		transport = c.Transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if c.TokenSource != nil {
+		transport = &tokenRoundTripper{
+			tokenSource: c.TokenSource,
+			next:        transport,
+		}
+	}
+	if c.RetryPolicy.MaxAttempts > 1 {
+		transport = &retryRoundTripper{
+			policy: c.RetryPolicy,
+			next:   transport,
+		}
+	}
+	return transport
+}
+
+type tokenRoundTripper struct {
+	tokenSource TokenSource
+	next        http.RoundTripper
+}
+
+func (t *tokenRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	token, err := t.tokenSource.Token(request.Context())
+	if err != nil {
+		if request.Body != nil {
+			_ = request.Body.Close()
+		}
+		return nil, fmt.Errorf("get token: %w", err)
+	}
+	response, err := t.roundTrip(request, request.Body, token)
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+	// Retry once with a new token, if the request body can be replayed.
+	if request.Body != nil && request.GetBody == nil {
+		return response, nil
+	}
+	if invalidator, ok := t.tokenSource.(TokenInvalidator); ok {
+		invalidator.InvalidateToken()
+	}
+	retryToken, err := t.tokenSource.Token(request.Context())
+	if err != nil || retryToken == token {
+		return response, nil
+	}
+	var retryBody io.ReadCloser
+	if request.Body != nil {
+		if retryBody, err = request.GetBody(); err != nil {
+			return response, nil
+		}
+	}
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+	return t.roundTrip(request, retryBody, retryToken)
+}
+
+func (t *tokenRoundTripper) roundTrip(request *http.Request, body io.ReadCloser, token string) (*http.Response, error) {
+	// Round trippers must not modify the original request.
+	request = request.Clone(request.Context())
+	request.Body = body
+	// An empty token sends the request without authentication.
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	return t.next.RoundTrip(request)
+}
+
+// Get sends a GET request and passes a 200 OK response to fn.
+func (c *Client) Get(
+	ctx context.Context,
+	path string,
+	query url.Values,
+	fn func(*http.Response) error,
+) error {
+	return c.Send(ctx, http.MethodGet, path, query, nil, []int{http.StatusOK}, fn)
+}
+
+// Stream sends a GET request for a long-lived response stream and passes a 200 OK response to fn.
+//
+// The timeout of the HTTP client limits reading the whole response body, and would cut off the stream, so the
+// request is only limited by ctx.
+func (c *Client) Stream(
+	ctx context.Context,
+	path string,
+	query url.Values,
+	fn func(*http.Response) error,
+) error {
+	httpClient := *c.httpClient
+	httpClient.Timeout = 0
+	stream := Client{baseURL: c.baseURL, httpClient: &httpClient}
+	return stream.Get(ctx, path, query, fn)
+}
+
+// Post sends a POST request with an optional JSON body and passes a 200 OK or 201 Created response to fn.
+func (c *Client) Post(
+	ctx context.Context,
+	path string,
+	query url.Values,
+	body any,
+	fn func(*http.Response) error,
+) error {
+	return c.Send(ctx, http.MethodPost, path, query, body, []int{http.StatusOK, http.StatusCreated}, fn)
+}
+
+// Delete sends a DELETE request and expects a 204 No Content response.
+func (c *Client) Delete(
+	ctx context.Context,
+	path string,
+) error {
+	return c.Send(ctx, http.MethodDelete, path, nil, nil, []int{http.StatusNoContent}, nil)
+}
+
+// Send sends an HTTP request with an optional JSON body.
+//
+// The response is passed to fn if its status code is one of the expected status codes, and is otherwise returned
+// as a [StatusError].
+func (c *Client) Send(
+	ctx context.Context,
+	method string,
+	path string,
+	query url.Values,
+	body any,
+	expectedStatusCodes []int,
+	fn func(*http.Response) error,
+) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("%s %s: %w", method, path, err)
+		}
+	}()
+	requestURL, err := url.Parse(c.baseURL + path)
+	if err != nil {
+		return err
+	}
+	if len(query) > 0 {
+		requestURL.RawQuery = query.Encode()
+	}
+	var bodyReader io.Reader
+	if body != nil {
+		bodyData, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		bodyReader = bytes.NewReader(bodyData)
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, method, requestURL.String(), bodyReader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = httpResponse.Body.Close()
+	}()
+	if !slices.Contains(expectedStatusCodes, httpResponse.StatusCode) {
+		return ReadStatusError(httpResponse)
+	}
+	if fn != nil {
+		return fn(httpResponse)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package backstagehttp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("transport", func(t *testing.T) {
+		var requests int
+		var authorization, userAgent string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			authorization = r.Header.Get("authorization")
+			userAgent = r.Header.Get("user-agent")
+		}))
+		t.Cleanup(server.Close)
+		httpClient := &http.Client{Timeout: time.Minute}
+		client := NewClient(func(config *Config) {
+			config.TokenSource = staticTokenSource("token")
+			config.BaseURL = server.URL
+			config.HTTPClient = httpClient
+			config.Transport = &userAgentRoundTripper{userAgent: "test", next: http.DefaultTransport}
+			config.RetryPolicy = RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+		})
+		assert.NilError(t, client.Get(ctx, "/api/test", nil, nil))
+		assert.Equal(t, 2, requests)
+		assert.Equal(t, "Bearer token", authorization)
+		assert.Equal(t, "test", userAgent)
+		assert.Equal(t, time.Minute, client.HTTPClient().Timeout)
+		assert.Assert(t, httpClient.Transport == nil)
+	})
+
+	t.Run("stream without timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("first\n"))
+			w.(http.Flusher).Flush()
+			time.Sleep(100 * time.Millisecond)
+			_, _ = w.Write([]byte("second\n"))
+		}))
+		t.Cleanup(server.Close)
+		httpClient := &http.Client{Timeout: 10 * time.Millisecond}
+		client := NewClient(func(config *Config) {
+			config.BaseURL = server.URL
+			config.HTTPClient = httpClient
+		})
+		var body []byte
+		assert.NilError(t, client.Stream(ctx, "/api/test", nil, func(response *http.Response) error {
+			var err error
+			body, err = io.ReadAll(response.Body)
+			return err
+		}))
+		assert.Equal(t, "first\nsecond\n", string(body))
+		assert.Equal(t, 10*time.Millisecond, client.HTTPClient().Timeout)
+	})
+
+	t.Run("unexpected status code", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"name":"NotFoundError","message":"not found"}}`))
+		}))
+		t.Cleanup(server.Close)
+		client := NewClient(func(config *Config) {
+			config.BaseURL = server.URL
+		})
+		err := client.Post(ctx, "/api/test", nil, map[string]string{"foo": "bar"}, nil)
+		var errStatus *StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, "NotFoundError", errStatus.Name)
+		assert.Assert(t, errors.Is(err, ErrNotFound))
+		assert.Error(t, err, "POST /api/test: 404 Not Found: not found")
+	})
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+type userAgentRoundTripper struct {
+	userAgent string
+	next      http.RoundTripper
+}
+
+func (u *userAgentRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	request.Header.Set("User-Agent", u.userAgent)
+	return u.next.RoundTrip(request)
+}
@@ -0,0 +1,2 @@
+// Package backstagehttp provides the HTTP plumbing shared by the clients of the Backstage APIs.
+package backstagehttp
@@ -0,0 +1,121 @@
+package backstagehttp
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// Sentinel errors for common HTTP status errors, for use with [errors.Is].
+var (
+	// ErrBadRequest is matched by a [StatusError] with status code 400.
+	ErrBadRequest = errors.New("bad request")
+	// ErrUnauthorized is matched by a [StatusError] with status code 401.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is matched by a [StatusError] with status code 403.
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound is matched by a [StatusError] with status code 404.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is matched by a [StatusError] with status code 409.
+	ErrConflict = errors.New("conflict")
+)
+
+// StatusError represents an HTTP status error.
+type StatusError struct {
+	// Status of the error.
+	Status string
+	// StatusCode of the error.
+	StatusCode int
+	// Name of the error reported by the server, e.g. "NotFoundError".
+	Name string
+	// Message of the error reported by the server.
+	Message string
+	// Cause of the error reported by the server, if any.
+	Cause *SerializedError
+	// RequestMethod is the method of the failed request, as reported by the server.
+	RequestMethod string
+	// RequestURL is the URL of the failed request, as reported by the server.
+	RequestURL string
+}
+
+// SerializedError is an error serialized by the Backstage backend.
+type SerializedError struct {
+	// Name of the error.
+	Name string `json:"name"`
+	// Message of the error.
+	Message string `json:"message"`
+	// Cause of the error, if any.
+	Cause *SerializedError `json:"cause,omitempty"`
+}
+
+// MaxErrorBodySize is the maximum size of an error response body to parse.
+const MaxErrorBodySize = 1 << 20
+
+// ReadStatusError creates a [StatusError] from an HTTP response, parsing the Backstage error response body, if any.
+//
+// The response body is read but not closed.
+func ReadStatusError(httpResponse *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(httpResponse.Body, MaxErrorBodySize))
+	return NewStatusError(httpResponse, body)
+}
+
+// NewStatusError creates a [StatusError] from an HTTP response and its already read body.
+func NewStatusError(httpResponse *http.Response, body []byte) error {
+	result := &StatusError{
+		Status:     httpResponse.Status,
+		StatusCode: httpResponse.StatusCode,
+	}
+	var errorResponse struct {
+		Error   *SerializedError `json:"error"`
+		Request *struct {
+			Method string `json:"method"`
+			URL    string `json:"url"`
+		} `json:"request"`
+	}
+	if err := json.Unmarshal(body, &errorResponse); err == nil {
+		if errorResponse.Error != nil {
+			result.Name = errorResponse.Error.Name
+			result.Message = errorResponse.Error.Message
+			result.Cause = errorResponse.Error.Cause
+		}
+		if errorResponse.Request != nil {
+			result.RequestMethod = errorResponse.Request.Method
+			result.RequestURL = errorResponse.Request.URL
+		}
+	}
+	return result
+}
+
+// Error implements error.
+func (s *StatusError) Error() string {
+	if s.Message == "" {
+		return s.Status
+	}
+	return s.Status + ": " + s.Message
+}
+
+// Is supports matching the error with sentinel errors such as [ErrNotFound], using [errors.Is].
+func (s *StatusError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return s.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return s.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return s.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return s.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return s.StatusCode == http.StatusConflict
+	}
+	return false
+}
+
+// Error implements error.
+func (s *SerializedError) Error() string {
+	if s.Name == "" {
+		return s.Message
+	}
+	return s.Name + ": " + s.Message
+}
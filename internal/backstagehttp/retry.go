@@ -0,0 +1,148 @@
+package backstagehttp
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures retries of failed requests.
+//
+// The fields are documented on catalog.RetryPolicy, which converts to this type.
+type RetryPolicy struct {
+	MaxAttempts          int
+	InitialBackoff       time.Duration
+	MaxBackoff           time.Duration
+	RetryableStatusCodes []int
+	RetryNonIdempotent   bool
+}
+
+const (
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 10 * time.Second
+)
+
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+func (p *RetryPolicy) canRetry(request *http.Request) bool {
+	if p.MaxAttempts < 2 {
+		return false
+	}
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return false
+	}
+	if p.RetryNonIdempotent {
+		return true
+	}
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func (p *RetryPolicy) isRetryableStatusCode(statusCode int) bool {
+	if len(p.RetryableStatusCodes) == 0 {
+		return slices.Contains(defaultRetryableStatusCodes, statusCode)
+	}
+	return slices.Contains(p.RetryableStatusCodes, statusCode)
+}
+
+// backoff returns the backoff before the provided retry, where the first retry is 1.
+func (p *RetryPolicy) backoff(retry int, httpResponse *http.Response) time.Duration {
+	if httpResponse != nil {
+		if retryAfter, ok := parseRetryAfter(httpResponse.Header.Get("Retry-After")); ok {
+			return retryAfter
+		}
+	}
+	initialBackoff := p.InitialBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = defaultInitialBackoff
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	backoff := initialBackoff
+	for i := 1; i < retry && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, maxBackoff)
+	return rand.N(backoff) + 1 //nolint:gosec // jitter does not need a secure random source
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// retryRoundTripper retries requests according to a retry policy.
+type retryRoundTripper struct {
+	policy RetryPolicy
+	next   http.RoundTripper
+}
+
+func (t *retryRoundTripper) RoundTrip(httpRequest *http.Request) (*http.Response, error) {
+	policy := &t.policy
+	if !policy.canRetry(httpRequest) {
+		return t.next.RoundTrip(httpRequest)
+	}
+	ctx := httpRequest.Context()
+	for attempt := 1; ; attempt++ {
+		httpResponse, err := t.next.RoundTrip(httpRequest)
+		if attempt >= policy.MaxAttempts || ctx.Err() != nil {
+			return httpResponse, err
+		}
+		if err == nil && !policy.isRetryableStatusCode(httpResponse.StatusCode) {
+			return httpResponse, nil
+		}
+		backoff := policy.backoff(attempt, httpResponse)
+		if httpResponse != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(httpResponse.Body, MaxErrorBodySize))
+			_ = httpResponse.Body.Close()
+		}
+		if err := sleep(ctx, backoff); err != nil {
+			return nil, err
+		}
+		// Round trippers must not modify the original request.
+		httpRequest = httpRequest.Clone(ctx)
+		if httpRequest.GetBody != nil {
+			body, err := httpRequest.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			httpRequest.Body = body
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,44 @@
+package backstagehttp
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	for _, tt := range []struct {
+		retry      int
+		maxBackoff time.Duration
+	}{
+		{retry: 1, maxBackoff: time.Second},
+		{retry: 2, maxBackoff: 2 * time.Second},
+		{retry: 3, maxBackoff: 4 * time.Second},
+		{retry: 4, maxBackoff: 5 * time.Second},
+		{retry: 100, maxBackoff: 5 * time.Second},
+	} {
+		for range 100 {
+			backoff := policy.backoff(tt.retry, nil)
+			assert.Assert(t, backoff > 0 && backoff <= tt.maxBackoff, "retry %d: %v", tt.retry, backoff)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	d, ok := parseRetryAfter("120")
+	assert.Assert(t, ok)
+	assert.Equal(t, 2*time.Minute, d)
+	d, ok = parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.Assert(t, ok)
+	assert.Assert(t, d > 59*time.Minute && d <= time.Hour)
+	d, ok = parseRetryAfter("Mon, 02 Jan 2006 15:04:05 GMT")
+	assert.Assert(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+	_, ok = parseRetryAfter("")
+	assert.Assert(t, !ok)
+	_, ok = parseRetryAfter("soon")
+	assert.Assert(t, !ok)
+}
@@ -0,0 +1,36 @@
+package scaffolder
+
+import "encoding/json"
+
+// Action is a scaffolder action that can be used in the steps of a template.
+type Action struct {
+	// ID of the action, e.g. "fetch:template".
+	ID string `json:"id"`
+
+	// Description of the action.
+	Description string `json:"description,omitempty"`
+
+	// Schema of the action's input and output.
+	Schema *ActionSchema `json:"schema,omitempty"`
+
+	// Examples of how to use the action.
+	Examples []*ActionExample `json:"examples,omitempty"`
+}
+
+// ActionSchema contains the JSON Schemas of an action's input and output.
+type ActionSchema struct {
+	// Input is the JSON Schema of the action's input.
+	Input json.RawMessage `json:"input,omitempty"`
+
+	// Output is the JSON Schema of the action's output.
+	Output json.RawMessage `json:"output,omitempty"`
+}
+
+// ActionExample is an example of how to use an action.
+type ActionExample struct {
+	// Description of the example.
+	Description string `json:"description"`
+
+	// Example is the example template step, as YAML.
+	Example string `json:"example"`
+}
@@ -0,0 +1,21 @@
+package scaffolder
+
+import (
+	"go.einride.tech/backstage/catalog"
+	"go.einride.tech/backstage/internal/backstagehttp"
+)
+
+// Client to the Backstage Scaffolder API.
+//
+// Requests that fail with an HTTP status error return a [catalog.StatusError].
+type Client struct {
+	client *backstagehttp.Client
+}
+
+// NewClient creates a new scaffolder API [Client].
+//
+// The client is configured with the options of the catalog client, such as [catalog.WithBaseURL] and
+// [catalog.WithToken], and shares its authentication and retries.
+func NewClient(options ...catalog.ClientOption) *Client {
+	return &Client{client: backstagehttp.NewClient(options...)}
+}
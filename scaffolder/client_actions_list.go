@@ -0,0 +1,30 @@
+package scaffolder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// ListActionsRequest is the request to the [Client.ListActions] method.
+type ListActionsRequest struct{}
+
+// ListActionsResponse is the response from the [Client.ListActions] method.
+type ListActionsResponse struct {
+	// Actions installed in the scaffolder.
+	Actions []*Action
+}
+
+// ListActions lists the actions installed in the scaffolder.
+//
+// See: https://backstage.io/docs/features/software-templates/builtin-actions
+func (c *Client) ListActions(ctx context.Context, _ *ListActionsRequest) (*ListActionsResponse, error) {
+	const path = "/api/scaffolder/v2/actions"
+	var actions []*Action
+	if err := c.client.Get(ctx, path, nil, func(response *http.Response) error {
+		return json.NewDecoder(response.Body).Decode(&actions)
+	}); err != nil {
+		return nil, err
+	}
+	return &ListActionsResponse{Actions: actions}, nil
+}
@@ -0,0 +1,62 @@
+package scaffolder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.einride.tech/backstage/catalog"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_ListActions(t *testing.T) {
+	ctx := context.Background()
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/scaffolder/v2/actions", r.URL.Path)
+			_, _ = w.Write([]byte(`[
+  {
+    "id": "fetch:template",
+    "description": "Downloads a skeleton and renders it.",
+    "schema": {"input": {"type": "object", "required": ["url"]}, "output": {"type": "object"}},
+    "examples": [{"description": "Fetch a skeleton", "example": "steps:\n  - action: fetch:template\n"}]
+  },
+  {"id": "debug:log"}
+]`))
+		})
+		response, err := client.ListActions(ctx, &ListActionsRequest{})
+		assert.NilError(t, err)
+		expected := &ListActionsResponse{
+			Actions: []*Action{
+				{
+					ID:          "fetch:template",
+					Description: "Downloads a skeleton and renders it.",
+					Schema: &ActionSchema{
+						Input:  json.RawMessage(`{"type": "object", "required": ["url"]}`),
+						Output: json.RawMessage(`{"type": "object"}`),
+					},
+					Examples: []*ActionExample{
+						{Description: "Fetch a skeleton", Example: "steps:\n  - action: fetch:template\n"},
+					},
+				},
+				{ID: "debug:log"},
+			},
+		}
+		assert.DeepEqual(t, expected, response)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusInternalServerError
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.ListActions(ctx, &ListActionsRequest{})
+		assert.Assert(t, response == nil)
+		var errStatus *catalog.StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
@@ -0,0 +1,120 @@
+package scaffolder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+
+	"go.einride.tech/backstage/catalog"
+)
+
+// DryRunRequest is the request to the [Client.DryRun] method.
+type DryRunRequest struct {
+	// Template entity to execute. Only v1beta3 templates are supported by the scaffolder.
+	Template *catalog.Entity `json:"template"`
+
+	// Values are the input values of the template parameters.
+	Values map[string]any `json:"values"`
+
+	// Secrets are secret input values, which are available to the template steps.
+	Secrets map[string]string `json:"secrets,omitempty"`
+
+	// DirectoryContents are the files of the template's directory, e.g. the skeleton of a fetch:template step.
+	DirectoryContents []*File `json:"directoryContents"`
+}
+
+// DryRunResponse is the response from the [Client.DryRun] method.
+type DryRunResponse struct {
+	// Log of the dry-run.
+	Log []*DryRunLogEntry `json:"log"`
+
+	// DirectoryContents are the files of the workspace after the dry-run.
+	DirectoryContents []*File `json:"directoryContents"`
+
+	// Output of the template.
+	Output json.RawMessage `json:"output,omitempty"`
+
+	// Steps of the template that were executed.
+	Steps []*catalog.TemplateStep `json:"steps,omitempty"`
+}
+
+// DryRunLogEntry is a log entry of a dry-run.
+type DryRunLogEntry struct {
+	// Body of the log entry.
+	Body TaskEventBody `json:"body"`
+}
+
+// File is a file uploaded to or returned from the scaffolder.
+type File struct {
+	// Path of the file, relative to the directory, with forward slashes.
+	Path string `json:"path"`
+
+	// Content of the file.
+	Content []byte `json:"base64Content"`
+
+	// Executable is true if the file is executable.
+	Executable bool `json:"executable,omitempty"`
+}
+
+// DryRun executes a template without side effects, and returns the log and resulting files.
+//
+// Steps of the template that don't support dry-runs are skipped by the scaffolder.
+func (c *Client) DryRun(ctx context.Context, request *DryRunRequest) (*DryRunResponse, error) {
+	const path = "/api/scaffolder/v2/dry-run"
+	if request == nil {
+		return nil, fmt.Errorf("%s %s: nil request", http.MethodPost, path)
+	}
+	body := *request
+	if body.Values == nil {
+		body.Values = map[string]any{}
+	}
+	if body.DirectoryContents == nil {
+		body.DirectoryContents = []*File{}
+	}
+	var response DryRunResponse
+	if err := c.client.Post(ctx, path, nil, &body, func(httpResponse *http.Response) error {
+		return json.NewDecoder(httpResponse.Body).Decode(&response)
+	}); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ReadFiles reads all regular files in a file system, e.g. a template directory to upload with a [DryRunRequest].
+//
+// Symbolic links and other non-regular files are not followed, and return an error, since the scaffolder only
+// accepts the contents of regular files.
+func ReadFiles(fsys fs.FS) ([]*File, error) {
+	var result []*File
+	if err := fs.WalkDir(fsys, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return fmt.Errorf("read files: %s: unsupported file type %s", filePath, d.Type())
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		content, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return err
+		}
+		result = append(result, &File{
+			Path:       path.Clean(filePath),
+			Content:    content,
+			Executable: info.Mode().Perm()&0o111 != 0,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
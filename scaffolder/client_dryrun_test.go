@@ -0,0 +1,111 @@
+package scaffolder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"testing"
+	"testing/fstest"
+
+	"go.einride.tech/backstage/catalog"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	ctx := context.Background()
+	//nolint: lll
+	const template = `{"apiVersion":"scaffolder.backstage.io/v1beta3","kind":"Template","metadata":{"name":"demo"},"spec":{"type":"service","steps":[{"id":"fetch","action":"fetch:template","input":{"url":"./skeleton"}}]}}`
+	var templateEntity catalog.Entity
+	assert.NilError(t, json.Unmarshal([]byte(template), &templateEntity))
+
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/scaffolder/v2/dry-run", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			assert.NilError(t, err)
+			//nolint: lll
+			const expected = `{"template":` + template + `,"values":{"name":"payments"},"directoryContents":[{"path":"skeleton/README.md","base64Content":"IyAke3sgdmFsdWVzLm5hbWUgfX0K"}]}`
+			assert.Equal(t, expected, string(body))
+			//nolint: lll
+			_, _ = w.Write([]byte(`{
+  "log": [{"body": {"message": "Beginning step fetch", "stepId": "fetch", "status": "processing"}}],
+  "directoryContents": [{"path": "README.md", "base64Content": "IyBwYXltZW50cwo=", "executable": false}, {"path": "run.sh", "base64Content": "IyEvYmluL3NoCg==", "executable": true}],
+  "output": {"links": []},
+  "steps": [{"id": "fetch", "name": "Fetch", "action": "fetch:template", "input": {"url": "./skeleton"}}]
+}`))
+		})
+		response, err := client.DryRun(ctx, &DryRunRequest{
+			Template: &templateEntity,
+			Values:   map[string]any{"name": "payments"},
+			DirectoryContents: []*File{
+				{Path: "skeleton/README.md", Content: []byte("# ${{ values.name }}\n")},
+			},
+		})
+		assert.NilError(t, err)
+		expected := &DryRunResponse{
+			Log: []*DryRunLogEntry{
+				{Body: TaskEventBody{Message: "Beginning step fetch", StepID: "fetch", Status: TaskStatusProcessing}},
+			},
+			DirectoryContents: []*File{
+				{Path: "README.md", Content: []byte("# payments\n")},
+				{Path: "run.sh", Content: []byte("#!/bin/sh\n"), Executable: true},
+			},
+			Output: json.RawMessage(`{"links": []}`),
+			Steps: []*catalog.TemplateStep{
+				{ID: "fetch", Name: "Fetch", Action: "fetch:template", Input: json.RawMessage(`{"url": "./skeleton"}`)},
+			},
+		}
+		assert.DeepEqual(t, expected, response)
+	})
+
+	t.Run("nil request", func(t *testing.T) {
+		client := newTestClient(t, func(http.ResponseWriter, *http.Request) {
+			t.Error("unexpected request")
+		})
+		response, err := client.DryRun(ctx, nil)
+		assert.Assert(t, response == nil)
+		assert.Error(t, err, "POST /api/scaffolder/v2/dry-run: nil request")
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusBadRequest
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.DryRun(ctx, &DryRunRequest{Template: &templateEntity})
+		assert.Assert(t, response == nil)
+		var errStatus *catalog.StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
+
+func TestReadFiles(t *testing.T) {
+	t.Run("regular files", func(t *testing.T) {
+		files, err := ReadFiles(fstest.MapFS{
+			"template.yaml":         {Data: []byte("kind: Template\n")},
+			"skeleton/README.md":    {Data: []byte("# ${{ values.name }}\n")},
+			"skeleton/bin/build.sh": {Data: []byte("#!/bin/sh\n"), Mode: 0o755},
+		})
+		assert.NilError(t, err)
+		expected := []*File{
+			{Path: "skeleton/README.md", Content: []byte("# ${{ values.name }}\n")},
+			{Path: "skeleton/bin/build.sh", Content: []byte("#!/bin/sh\n"), Executable: true},
+			{Path: "template.yaml", Content: []byte("kind: Template\n")},
+		}
+		assert.DeepEqual(t, expected, files)
+	})
+
+	t.Run("symlink", func(t *testing.T) {
+		files, err := ReadFiles(fstest.MapFS{
+			"skeleton/README.md": {Data: []byte("# ${{ values.name }}\n")},
+			"skeleton/link":      {Data: []byte("README.md"), Mode: fs.ModeSymlink},
+		})
+		assert.Assert(t, files == nil)
+		assert.ErrorContains(t, err, "skeleton/link: unsupported file type")
+	})
+}
@@ -0,0 +1,34 @@
+package scaffolder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CancelTaskRequest is the request to the [Client.CancelTask] method.
+type CancelTaskRequest struct {
+	// TaskID is the ID of the task to cancel.
+	TaskID string
+}
+
+// CancelTaskResponse is the response from the [Client.CancelTask] method.
+type CancelTaskResponse struct {
+	// Status of the task after the cancellation.
+	Status TaskStatus `json:"status"`
+}
+
+// CancelTask cancels a running scaffolder task.
+func (c *Client) CancelTask(ctx context.Context, request *CancelTaskRequest) (*CancelTaskResponse, error) {
+	const pathTemplate = "/api/scaffolder/v2/tasks/%s/cancel"
+	path := fmt.Sprintf(pathTemplate, url.PathEscape(request.TaskID))
+	var response CancelTaskResponse
+	if err := c.client.Post(ctx, path, nil, nil, func(httpResponse *http.Response) error {
+		return json.NewDecoder(httpResponse.Body).Decode(&response)
+	}); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,38 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.einride.tech/backstage/catalog"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_CancelTask(t *testing.T) {
+	ctx := context.Background()
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/scaffolder/v2/tasks/a1b2c3/cancel", r.URL.Path)
+			_, _ = w.Write([]byte(`{"status":"cancelled"}`))
+		})
+		response, err := client.CancelTask(ctx, &CancelTaskRequest{TaskID: "a1b2c3"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, &CancelTaskResponse{Status: TaskStatusCancelled}, response)
+		assert.Assert(t, response.Status.IsDone())
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusNotFound
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.CancelTask(ctx, &CancelTaskRequest{TaskID: "a1b2c3"})
+		assert.Assert(t, response == nil)
+		var errStatus *catalog.StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
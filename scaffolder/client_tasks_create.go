@@ -0,0 +1,41 @@
+package scaffolder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// CreateTaskRequest is the request to the [Client.CreateTask] method.
+type CreateTaskRequest struct {
+	// TemplateRef is an entity reference to the template to execute, e.g. "template:default/create-service".
+	TemplateRef string `json:"templateRef"`
+
+	// Values are the input values of the template parameters.
+	Values map[string]any `json:"values"`
+
+	// Secrets are secret input values, which are available to the template steps but not stored with the task.
+	Secrets map[string]string `json:"secrets,omitempty"`
+}
+
+// CreateTaskResponse is the response from the [Client.CreateTask] method.
+type CreateTaskResponse struct {
+	// ID of the created task.
+	ID string `json:"id"`
+}
+
+// CreateTask starts a scaffolder task that executes a template with the provided input values.
+func (c *Client) CreateTask(ctx context.Context, request *CreateTaskRequest) (*CreateTaskResponse, error) {
+	const path = "/api/scaffolder/v2/tasks"
+	body := *request
+	if body.Values == nil {
+		body.Values = map[string]any{}
+	}
+	var response CreateTaskResponse
+	if err := c.client.Post(ctx, path, nil, &body, func(httpResponse *http.Response) error {
+		return json.NewDecoder(httpResponse.Body).Decode(&response)
+	}); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
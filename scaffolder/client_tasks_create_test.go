@@ -0,0 +1,63 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"go.einride.tech/backstage/catalog"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_CreateTask(t *testing.T) {
+	ctx := context.Background()
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/api/scaffolder/v2/tasks", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			body, err := io.ReadAll(r.Body)
+			assert.NilError(t, err)
+			//nolint: lll
+			const expected = `{"templateRef":"template:default/create-service","values":{"name":"payments","replicas":2},"secrets":{"token":"secret"}}`
+			assert.Equal(t, expected, string(body))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"a1b2c3"}`))
+		})
+		response, err := client.CreateTask(ctx, &CreateTaskRequest{
+			TemplateRef: "template:default/create-service",
+			Values:      map[string]any{"name": "payments", "replicas": 2},
+			Secrets:     map[string]string{"token": "secret"},
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, &CreateTaskResponse{ID: "a1b2c3"}, response)
+	})
+
+	t.Run("no values", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NilError(t, err)
+			assert.Equal(t, `{"templateRef":"template:default/create-service","values":{}}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"a1b2c3"}`))
+		})
+		_, err := client.CreateTask(ctx, &CreateTaskRequest{TemplateRef: "template:default/create-service"})
+		assert.NilError(t, err)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusBadRequest
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(statusCode)
+			_, _ = w.Write([]byte(`{"error":{"name":"InputError","message":"Invalid values"}}`))
+		})
+		response, err := client.CreateTask(ctx, &CreateTaskRequest{TemplateRef: "template:default/create-service"})
+		assert.Assert(t, response == nil)
+		var errStatus *catalog.StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+		assert.Equal(t, "InputError", errStatus.Name)
+	})
+}
@@ -0,0 +1,113 @@
+package scaffolder
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// StreamTaskEventsRequest is the request to the [Client.StreamTaskEvents] method.
+type StreamTaskEventsRequest struct {
+	// TaskID is the ID of the task to stream events from.
+	TaskID string
+
+	// After is the ID of the last event already received. Only events after it are streamed.
+	// Zero streams all events of the task.
+	After int64
+}
+
+// StreamTaskEvents streams the events of a scaffolder task, as they happen.
+//
+// The events are read from the task's server-sent event stream. The iteration ends after the
+// [TaskEventTypeCompletion] event, when the server closes the stream, or on the first error.
+// Breaking the iteration closes the stream.
+//
+// The stream is not limited by the timeout of the client's HTTP client, only by ctx.
+func (c *Client) StreamTaskEvents(ctx context.Context, request *StreamTaskEventsRequest) iter.Seq2[*TaskEvent, error] {
+	return func(yield func(*TaskEvent, error) bool) {
+		const pathTemplate = "/api/scaffolder/v2/tasks/%s/eventstream"
+		path := fmt.Sprintf(pathTemplate, url.PathEscape(request.TaskID))
+		query := url.Values{}
+		if request.After > 0 {
+			query.Set("after", strconv.FormatInt(request.After, 10))
+		}
+		err := c.client.Stream(ctx, path, query, func(response *http.Response) error {
+			for event, err := range readServerSentEvents(response) {
+				if err != nil {
+					return err
+				}
+				var taskEvent TaskEvent
+				if err := json.Unmarshal([]byte(event.data), &taskEvent); err != nil {
+					return fmt.Errorf("decode %s event: %w", event.name, err)
+				}
+				if !yield(&taskEvent, nil) {
+					return errStopIteration
+				}
+				if taskEvent.Type == TaskEventTypeCompletion {
+					return nil
+				}
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errStopIteration) {
+			yield(nil, err)
+		}
+	}
+}
+
+// errStopIteration is returned from response handlers when the caller stops an iteration.
+var errStopIteration = errors.New("stop iteration")
+
+// serverSentEvent is an event of a server-sent event stream.
+type serverSentEvent struct {
+	name string
+	data string
+}
+
+// readServerSentEvents reads the events with data from a server-sent event stream.
+//
+// See: https://html.spec.whatwg.org/multipage/server-sent-events.html#event-stream-interpretation
+func readServerSentEvents(response *http.Response) iter.Seq2[*serverSentEvent, error] {
+	return func(yield func(*serverSentEvent, error) bool) {
+		scanner := bufio.NewScanner(response.Body)
+		// Events with large log messages or outputs may exceed the default max line length.
+		scanner.Buffer(nil, 16<<20)
+		var name string
+		var data []string
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" {
+				// A blank line dispatches the event.
+				if len(data) > 0 {
+					if !yield(&serverSentEvent{name: name, data: strings.Join(data, "\n")}, nil) {
+						return
+					}
+				}
+				name, data = "", nil
+				continue
+			}
+			if strings.HasPrefix(line, ":") {
+				// Comment, e.g. a keep-alive.
+				continue
+			}
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "event":
+				name = value
+			case "data":
+				data = append(data, value)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package scaffolder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.einride.tech/backstage/catalog"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_StreamTaskEvents(t *testing.T) {
+	ctx := context.Background()
+	//nolint: lll
+	const eventStream = `: keep-alive
+
+event: log
+data: {"id":1,"taskId":"a1b2c3","type":"log","body":{"message":"Beginning step Fetch Base","stepId":"fetch-base","status":"processing"},"createdAt":"2024-05-01T10:00:00.000Z"}
+
+event: log
+data: {"id":2,"taskId":"a1b2c3","type":"log",
+data: "body":{"message":"Finished step Fetch Base","stepId":"fetch-base","status":"completed"},"createdAt":"2024-05-01T10:00:01.000Z"}
+
+event: completion
+data: {"id":3,"taskId":"a1b2c3","type":"completion","body":{"message":"Run completed with status: completed","output":{"links":[]}},"createdAt":"2024-05-01T10:00:02.000Z"}
+
+event: log
+data: {"id":4,"taskId":"a1b2c3","type":"log","body":{"message":"After completion"}}
+
+`
+	newEventStreamTestClient := func(t *testing.T) *Client {
+		return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/scaffolder/v2/tasks/a1b2c3/eventstream", r.URL.Path)
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, _ = w.Write([]byte(eventStream))
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		client := newEventStreamTestClient(t)
+		var events []*TaskEvent
+		for event, err := range client.StreamTaskEvents(ctx, &StreamTaskEventsRequest{TaskID: "a1b2c3"}) {
+			assert.NilError(t, err)
+			events = append(events, event)
+		}
+		expected := []*TaskEvent{
+			{
+				ID:     1,
+				TaskID: "a1b2c3",
+				Type:   TaskEventTypeLog,
+				Body: TaskEventBody{
+					Message: "Beginning step Fetch Base",
+					StepID:  "fetch-base",
+					Status:  TaskStatusProcessing,
+				},
+				CreatedAt: "2024-05-01T10:00:00.000Z",
+			},
+			{
+				ID:     2,
+				TaskID: "a1b2c3",
+				Type:   TaskEventTypeLog,
+				Body: TaskEventBody{
+					Message: "Finished step Fetch Base",
+					StepID:  "fetch-base",
+					Status:  TaskStatusCompleted,
+				},
+				CreatedAt: "2024-05-01T10:00:01.000Z",
+			},
+			{
+				ID:     3,
+				TaskID: "a1b2c3",
+				Type:   TaskEventTypeCompletion,
+				Body: TaskEventBody{
+					Message: "Run completed with status: completed",
+					Output:  json.RawMessage(`{"links":[]}`),
+				},
+				CreatedAt: "2024-05-01T10:00:02.000Z",
+			},
+		}
+		assert.DeepEqual(t, expected, events)
+	})
+
+	t.Run("after", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2", r.URL.Query().Get("after"))
+			_, _ = w.Write([]byte("event: log\ndata: {\"id\":3,\"type\":\"completion\"}\n\n"))
+		})
+		var ids []int64
+		for event, err := range client.StreamTaskEvents(ctx, &StreamTaskEventsRequest{TaskID: "a1b2c3", After: 2}) {
+			assert.NilError(t, err)
+			ids = append(ids, event.ID)
+		}
+		assert.DeepEqual(t, []int64{3}, ids)
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		client := newEventStreamTestClient(t)
+		var ids []int64
+		for event, err := range client.StreamTaskEvents(ctx, &StreamTaskEventsRequest{TaskID: "a1b2c3"}) {
+			assert.NilError(t, err)
+			ids = append(ids, event.ID)
+			break
+		}
+		assert.DeepEqual(t, []int64{1}, ids)
+	})
+
+	t.Run("invalid event", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("event: log\ndata: not json\n\n"))
+		})
+		var errs []error
+		for _, err := range client.StreamTaskEvents(ctx, &StreamTaskEventsRequest{TaskID: "a1b2c3"}) {
+			errs = append(errs, err)
+		}
+		assert.Equal(t, 1, len(errs))
+		assert.ErrorContains(t, errs[0], "decode log event")
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusNotFound
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(statusCode)
+		})
+		var errs []error
+		for event, err := range client.StreamTaskEvents(ctx, &StreamTaskEventsRequest{TaskID: "a1b2c3"}) {
+			assert.Assert(t, event == nil)
+			errs = append(errs, err)
+		}
+		assert.Equal(t, 1, len(errs))
+		var errStatus *catalog.StatusError
+		assert.Assert(t, errors.As(errs[0], &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
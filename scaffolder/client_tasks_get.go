@@ -0,0 +1,28 @@
+package scaffolder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetTaskRequest is the request to the [Client.GetTask] method.
+type GetTaskRequest struct {
+	// TaskID is the ID of the task to get.
+	TaskID string
+}
+
+// GetTask gets a scaffolder task by its ID.
+func (c *Client) GetTask(ctx context.Context, request *GetTaskRequest) (*Task, error) {
+	const pathTemplate = "/api/scaffolder/v2/tasks/%s"
+	path := fmt.Sprintf(pathTemplate, url.PathEscape(request.TaskID))
+	var task Task
+	if err := c.client.Get(ctx, path, nil, func(response *http.Response) error {
+		return json.NewDecoder(response.Body).Decode(&task)
+	}); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
@@ -0,0 +1,55 @@
+package scaffolder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"go.einride.tech/backstage/catalog"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_GetTask(t *testing.T) {
+	ctx := context.Background()
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/scaffolder/v2/tasks/a1b2c3", r.URL.Path)
+			_, _ = w.Write([]byte(`{
+  "id": "a1b2c3",
+  "spec": {"apiVersion": "scaffolder.backstage.io/v1beta3", "steps": []},
+  "status": "processing",
+  "createdAt": "2024-05-01T10:00:00.000Z",
+  "lastHeartbeatAt": "2024-05-01T10:00:05.000Z",
+  "createdBy": "user:default/jane"
+}`))
+		})
+		task, err := client.GetTask(ctx, &GetTaskRequest{TaskID: "a1b2c3"})
+		assert.NilError(t, err)
+		expected := &Task{
+			ID:              "a1b2c3",
+			Spec:            json.RawMessage(`{"apiVersion": "scaffolder.backstage.io/v1beta3", "steps": []}`),
+			Status:          TaskStatusProcessing,
+			CreatedAt:       "2024-05-01T10:00:00.000Z",
+			LastHeartbeatAt: "2024-05-01T10:00:05.000Z",
+			CreatedBy:       "user:default/jane",
+		}
+		assert.DeepEqual(t, expected, task)
+		assert.Assert(t, !task.Status.IsDone())
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusNotFound
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/scaffolder/v2/tasks/a1b2c3", r.URL.Path)
+			w.WriteHeader(statusCode)
+		})
+		task, err := client.GetTask(ctx, &GetTaskRequest{TaskID: "a1b2c3"})
+		assert.Assert(t, task == nil)
+		var errStatus *catalog.StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
@@ -0,0 +1,111 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"go.einride.tech/backstage/catalog"
+	"go.einride.tech/backstage/internal/backstagehttp/backstagehttptest"
+	"gotest.tools/v3/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("authorization", func(t *testing.T) {
+		var authorization string
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("authorization")
+			_, _ = w.Write([]byte("[]"))
+		})
+		_, err := client.ListActions(ctx, &ListActionsRequest{})
+		assert.NilError(t, err)
+		assert.Equal(t, "Bearer "+backstagehttptest.Token, authorization)
+	})
+
+	t.Run("token source", func(t *testing.T) {
+		var authorization string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("authorization")
+			_, _ = w.Write([]byte("[]"))
+		}))
+		t.Cleanup(server.Close)
+		client := NewClient(
+			catalog.WithBaseURL(server.URL),
+			catalog.WithTokenSource(catalog.TokenSourceFunc(func(context.Context) (string, error) {
+				return "rotated", nil
+			})),
+		)
+		_, err := client.ListActions(ctx, &ListActionsRequest{})
+		assert.NilError(t, err)
+		assert.Equal(t, "Bearer rotated", authorization)
+	})
+
+	t.Run("transport", func(t *testing.T) {
+		var transportCalls atomic.Int64
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("[]"))
+		}))
+		t.Cleanup(server.Close)
+		client := NewClient(
+			catalog.WithBaseURL(server.URL),
+			catalog.WithToken(backstagehttptest.Token),
+			catalog.WithHTTPClient(&http.Client{
+				Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					transportCalls.Add(1)
+					assert.Equal(t, "Bearer "+backstagehttptest.Token, r.Header.Get("authorization"))
+					return http.DefaultTransport.RoundTrip(r)
+				}),
+			}),
+		)
+		_, err := client.ListActions(ctx, &ListActionsRequest{})
+		assert.NilError(t, err)
+		assert.Equal(t, int64(1), transportCalls.Load())
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		var requests atomic.Int64
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if requests.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte("[]"))
+		}))
+		t.Cleanup(server.Close)
+		client := NewClient(
+			catalog.WithBaseURL(server.URL),
+			catalog.WithToken(backstagehttptest.Token),
+			catalog.WithRetryPolicy(catalog.RetryPolicy{MaxAttempts: 2, InitialBackoff: 1}),
+		)
+		_, err := client.ListActions(ctx, &ListActionsRequest{})
+		assert.NilError(t, err)
+		assert.Equal(t, int64(2), requests.Load())
+	})
+
+	t.Run("status error", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"name":"NotFoundError","message":"Task 123 does not exist"}}`))
+		})
+		_, err := client.GetTask(ctx, &GetTaskRequest{TaskID: "123"})
+		var errStatus *catalog.StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, "Task 123 does not exist", errStatus.Message)
+		assert.ErrorIs(t, err, catalog.ErrNotFound)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func newTestClient(t testing.TB, handler func(http.ResponseWriter, *http.Request)) *Client {
+	return NewClient(backstagehttptest.NewServer(t, handler)...)
+}
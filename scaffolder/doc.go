@@ -0,0 +1,2 @@
+// Package scaffolder provides primitives for the Backstage Scaffolder API.
+package scaffolder
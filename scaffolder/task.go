@@ -0,0 +1,107 @@
+package scaffolder
+
+import (
+	"encoding/json"
+
+	"go.einride.tech/backstage/catalog"
+)
+
+// Task is a scaffolder task, i.e. an execution of a template.
+type Task struct {
+	// ID of the task.
+	ID string `json:"id"`
+
+	// Spec of the task, containing the template steps, parameters and output.
+	Spec json.RawMessage `json:"spec,omitempty"`
+
+	// Status of the task.
+	Status TaskStatus `json:"status"`
+
+	// CreatedAt is the time the task was created, as an ISO 8601 timestamp.
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	// LastHeartbeatAt is the time of the last heartbeat from the worker processing the task, as an ISO 8601 timestamp.
+	LastHeartbeatAt string `json:"lastHeartbeatAt,omitempty"`
+
+	// CreatedBy is an entity reference to the user that created the task.
+	CreatedBy string `json:"createdBy,omitempty"`
+}
+
+// TaskStatus represents the status of a scaffolder task.
+type TaskStatus string
+
+// Known TaskStatus values.
+const (
+	// TaskStatusOpen is a task waiting to be processed.
+	TaskStatusOpen TaskStatus = "open"
+	// TaskStatusProcessing is a task being processed.
+	TaskStatusProcessing TaskStatus = "processing"
+	// TaskStatusCompleted is a task that completed successfully.
+	TaskStatusCompleted TaskStatus = "completed"
+	// TaskStatusFailed is a task that failed.
+	TaskStatusFailed TaskStatus = "failed"
+	// TaskStatusCancelled is a task that was cancelled.
+	TaskStatusCancelled TaskStatus = "cancelled"
+)
+
+// IsDone returns true if the status is a final status.
+func (s TaskStatus) IsDone() bool {
+	switch s {
+	case TaskStatusCompleted, TaskStatusFailed, TaskStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// TaskEvent is an event of a scaffolder task, e.g. a log message.
+type TaskEvent struct {
+	// ID of the event. Event IDs are increasing within a task.
+	ID int64 `json:"id"`
+
+	// TaskID is the ID of the task of the event.
+	TaskID string `json:"taskId"`
+
+	// Type of the event.
+	Type TaskEventType `json:"type"`
+
+	// Body of the event.
+	Body TaskEventBody `json:"body"`
+
+	// CreatedAt is the time the event was created, as an ISO 8601 timestamp.
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// TaskEventBody is the body of a [TaskEvent].
+type TaskEventBody struct {
+	// Message of the event.
+	Message string `json:"message,omitempty"`
+
+	// StepID is the ID of the template step the event relates to, if any.
+	StepID string `json:"stepId,omitempty"`
+
+	// Status of the step or task, if the event reports a status change.
+	//
+	// Steps report the same statuses as tasks, and also "skipped" for steps whose condition is not met.
+	Status TaskStatus `json:"status,omitempty"`
+
+	// Output of the task, for completion events of successful tasks.
+	Output json.RawMessage `json:"output,omitempty"`
+
+	// Error of the task, for completion events of failed tasks.
+	Error *catalog.SerializedError `json:"error,omitempty"`
+}
+
+// TaskEventType represents the type of a scaffolder task event.
+type TaskEventType string
+
+// Known TaskEventType values.
+const (
+	// TaskEventTypeLog is a log event.
+	TaskEventTypeLog TaskEventType = "log"
+	// TaskEventTypeCompletion is the final event of a task.
+	TaskEventTypeCompletion TaskEventType = "completion"
+	// TaskEventTypeCancelled is the event of a task being cancelled.
+	TaskEventTypeCancelled TaskEventType = "cancelled"
+	// TaskEventTypeRecovered is the event of a task being recovered after a restart of the scaffolder.
+	TaskEventTypeRecovered TaskEventType = "recovered"
+)
@@ -0,0 +1,82 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+// QueryRequest is the request to the [Client.Query] method.
+type QueryRequest struct {
+	// Term to search for.
+	Term string
+
+	// Types of documents to search, e.g. "software-catalog". Searches all types if empty.
+	Types []string
+
+	// Filters on document fields, e.g. "kind" to "Component".
+	// Multiple values of a filter match documents with any of the values.
+	Filters map[string][]string
+
+	// PageLimit is the maximum number of results per page. The search engine's default is used if zero.
+	PageLimit int64
+
+	// PageCursor is the cursor of the page to get, from a previous [QueryResponse].
+	PageCursor string
+}
+
+// QueryResponse is the response from the [Client.Query] method.
+type QueryResponse struct {
+	// Results of the query.
+	Results []*Result `json:"results"`
+
+	// NextPageCursor is the cursor of the next page of results, if any.
+	NextPageCursor string `json:"nextPageCursor,omitempty"`
+
+	// PreviousPageCursor is the cursor of the previous page of results, if any.
+	PreviousPageCursor string `json:"previousPageCursor,omitempty"`
+
+	// NumberOfResults is the total number of results, if reported by the search engine.
+	NumberOfResults int64 `json:"numberOfResults,omitempty"`
+}
+
+// Query searches for documents.
+//
+// See: https://backstage.io/docs/features/search/search-overview
+func (c *Client) Query(ctx context.Context, request *QueryRequest) (*QueryResponse, error) {
+	const path = "/api/search/query"
+	query := url.Values{}
+	query.Set("term", request.Term)
+	for i, t := range request.Types {
+		query.Set(fmt.Sprintf("types[%d]", i), t)
+	}
+	for _, key := range slices.Sorted(maps.Keys(request.Filters)) {
+		switch values := request.Filters[key]; len(values) {
+		case 0:
+		case 1:
+			query.Set("filters["+key+"]", values[0])
+		default:
+			for i, value := range values {
+				query.Set(fmt.Sprintf("filters[%s][%d]", key, i), value)
+			}
+		}
+	}
+	if request.PageLimit > 0 {
+		query.Set("pageLimit", strconv.FormatInt(request.PageLimit, 10))
+	}
+	if request.PageCursor != "" {
+		query.Set("pageCursor", request.PageCursor)
+	}
+	var response QueryResponse
+	if err := c.client.Get(ctx, path, query, func(httpResponse *http.Response) error {
+		return json.NewDecoder(httpResponse.Body).Decode(&response)
+	}); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,113 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"go.einride.tech/backstage/catalog"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_Query(t *testing.T) {
+	ctx := context.Background()
+	t.Run("success", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/search/query", r.URL.Path)
+			assert.DeepEqual(t, url.Values{
+				"term":              {"payments"},
+				"types[0]":          {"software-catalog"},
+				"types[1]":          {"techdocs"},
+				"filters[kind]":     {"Component"},
+				"filters[owner][0]": {"team-a"},
+				"filters[owner][1]": {"team-b"},
+				"pageLimit":         {"10"},
+				"pageCursor":        {"MQ=="},
+			}, r.URL.Query())
+			_, _ = w.Write([]byte(`{
+  "results": [
+    {
+      "type": "software-catalog",
+      "document": {
+        "title": "payments-api",
+        "text": "API for processing payments",
+        "location": "/catalog/default/component/payments-api",
+        "kind": "Component",
+        "owner": "team-a"
+      },
+      "highlight": {
+        "preTag": "<em>",
+        "postTag": "</em>",
+        "fields": {"title": "<em>payments</em>-api"}
+      },
+      "rank": 11
+    },
+    {
+      "type": "techdocs",
+      "document": {"title": "Payments", "text": "How payments work", "location": "/docs/default/component/payments"}
+    }
+  ],
+  "nextPageCursor": "Mg==",
+  "previousPageCursor": "MA==",
+  "numberOfResults": 42
+}`))
+		})
+		response, err := client.Query(ctx, &QueryRequest{
+			Term:  "payments",
+			Types: []string{"software-catalog", "techdocs"},
+			Filters: map[string][]string{
+				"kind":  {"Component"},
+				"owner": {"team-a", "team-b"},
+				"empty": {},
+			},
+			PageLimit:  10,
+			PageCursor: "MQ==",
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, 2, len(response.Results))
+		first := response.Results[0]
+		assert.Equal(t, "software-catalog", first.Type)
+		assert.Equal(t, "payments-api", first.Document.Title)
+		assert.Equal(t, "API for processing payments", first.Document.Text)
+		assert.Equal(t, "/catalog/default/component/payments-api", first.Document.Location)
+		assert.Assert(t, len(first.Document.Raw) > 0)
+		assert.DeepEqual(t, &Highlight{
+			PreTag:  "<em>",
+			PostTag: "</em>",
+			Fields:  map[string]string{"title": "<em>payments</em>-api"},
+		}, first.Highlight)
+		assert.Equal(t, int64(11), first.Rank)
+		second := response.Results[1]
+		assert.Equal(t, "techdocs", second.Type)
+		assert.Equal(t, "Payments", second.Document.Title)
+		assert.Assert(t, second.Highlight == nil)
+		assert.Equal(t, "Mg==", response.NextPageCursor)
+		assert.Equal(t, "MA==", response.PreviousPageCursor)
+		assert.Equal(t, int64(42), response.NumberOfResults)
+	})
+
+	t.Run("term only", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.DeepEqual(t, url.Values{"term": {"payments"}}, r.URL.Query())
+			_, _ = w.Write([]byte(`{"results":[]}`))
+		})
+		response, err := client.Query(ctx, &QueryRequest{Term: "payments"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, &QueryResponse{Results: []*Result{}}, response)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		const statusCode = http.StatusBadRequest
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(statusCode)
+		})
+		response, err := client.Query(ctx, &QueryRequest{Term: "payments"})
+		assert.Assert(t, response == nil)
+		var errStatus *catalog.StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, statusCode, errStatus.StatusCode)
+	})
+}
@@ -0,0 +1,65 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"go.einride.tech/backstage/catalog"
+	"go.einride.tech/backstage/internal/backstagehttp/backstagehttptest"
+	"gotest.tools/v3/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("authorization", func(t *testing.T) {
+		var authorization string
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("authorization")
+			_, _ = w.Write([]byte(`{"results":[]}`))
+		})
+		_, err := client.Query(ctx, &QueryRequest{Term: "payments"})
+		assert.NilError(t, err)
+		assert.Equal(t, "Bearer "+backstagehttptest.Token, authorization)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		var requests atomic.Int64
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if requests.Add(1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(`{"results":[]}`))
+		}))
+		t.Cleanup(server.Close)
+		client := NewClient(
+			catalog.WithBaseURL(server.URL),
+			catalog.WithToken(backstagehttptest.Token),
+			catalog.WithRetryPolicy(catalog.RetryPolicy{MaxAttempts: 2, InitialBackoff: 1}),
+		)
+		_, err := client.Query(ctx, &QueryRequest{Term: "payments"})
+		assert.NilError(t, err)
+		assert.Equal(t, int64(2), requests.Load())
+	})
+
+	t.Run("status error", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"name":"NotAllowedError","message":"Unauthorized"}}`))
+		})
+		_, err := client.Query(ctx, &QueryRequest{Term: "payments"})
+		var errStatus *catalog.StatusError
+		assert.Assert(t, errors.As(err, &errStatus))
+		assert.Equal(t, "NotAllowedError", errStatus.Name)
+		assert.ErrorIs(t, err, catalog.ErrForbidden)
+	})
+}
+
+func newTestClient(t testing.TB, handler func(http.ResponseWriter, *http.Request)) *Client {
+	return NewClient(backstagehttptest.NewServer(t, handler)...)
+}
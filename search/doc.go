@@ -0,0 +1,2 @@
+// Package search provides primitives for the Backstage Search API.
+package search
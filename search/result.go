@@ -0,0 +1,58 @@
+package search
+
+import "encoding/json"
+
+// Result is a search result.
+type Result struct {
+	// Type of the document, e.g. "software-catalog" or "techdocs".
+	Type string `json:"type"`
+
+	// Document that matched the search.
+	Document Document `json:"document"`
+
+	// Highlight of the matching terms in the document, if supported by the search engine.
+	Highlight *Highlight `json:"highlight,omitempty"`
+
+	// Rank of the result in the overall search results, starting at 1.
+	Rank int64 `json:"rank,omitempty"`
+}
+
+// Document is a document indexed by the search engine.
+type Document struct {
+	// Title of the document.
+	Title string `json:"title"`
+
+	// Text of the document.
+	Text string `json:"text"`
+
+	// Location of the document, as a URL or path in the Backstage app.
+	Location string `json:"location"`
+
+	// Raw document JSON message, including fields specific to the document type, e.g. "kind" and "owner" for
+	// software catalog documents.
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (d *Document) UnmarshalJSON(data []byte) error {
+	type document Document
+	var fields document
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	*d = Document(fields)
+	d.Raw = data
+	return nil
+}
+
+// Highlight contains the highlighted fields of a search result.
+type Highlight struct {
+	// PreTag marks the start of a highlighted term.
+	PreTag string `json:"preTag"`
+
+	// PostTag marks the end of a highlighted term.
+	PostTag string `json:"postTag"`
+
+	// Fields with highlighted terms, by field name, e.g. "title".
+	Fields map[string]string `json:"fields"`
+}